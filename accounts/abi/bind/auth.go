@@ -78,6 +78,51 @@ func NewKeyedTransactorWithKeystore(address common.Address, ks *keystore.KeyStor
 	}
 }
 
+// NewWalletTransactor is a utility method to easily create a transaction signer
+// from an account wallet, such as an external signer.
+func NewWalletTransactor(address common.Address, wallet accounts.Wallet, chainID *big.Int) *TransactOpts {
+	keyAddr := address
+	return &TransactOpts{
+		From: keyAddr,
+		Signer: func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != keyAddr {
+				return nil, errors.New("not authorized to sign this account")
+			}
+			account := accounts.Account{Address: address}
+			return wallet.SignTx(account, tx, chainID)
+		},
+	}
+}
+
+// NewKeyedFeePayerSigner is a utility method to easily create a fee payer signer
+// of fee-delegated transactions from a single private key.
+func NewKeyedFeePayerSigner(key *ecdsa.PrivateKey) SignerFn {
+	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
+	return func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != keyAddr {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		if err := tx.SignFeePayer(signer, key); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+}
+
+// NewWalletFeePayerSigner is a utility method to easily create a fee payer signer
+// of fee-delegated transactions from an account wallet. The wallet signs with the
+// keys of the fee payer role if the account has a role-based key.
+func NewWalletFeePayerSigner(address common.Address, wallet accounts.Wallet, chainID *big.Int) SignerFn {
+	keyAddr := address
+	return func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != keyAddr {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		account := accounts.Account{Address: address}
+		return wallet.SignTxAsFeePayer(account, tx, chainID)
+	}
+}
+
 // MakeTransactOpts creates a transaction signer with nonce, gasLimit, and gasPrice from a single private key.
 func MakeTransactOpts(accountKey *ecdsa.PrivateKey, nonce *big.Int, gasLimit uint64, gasPrice *big.Int) *TransactOpts {
 	if accountKey == nil {
@@ -102,3 +147,16 @@ func MakeTransactOptsWithKeystore(ks *keystore.KeyStore, from common.Address, no
 	auth.Nonce = nonce
 	return auth
 }
+
+// MakeTransactOptsWithWallet creates a transaction signer with nonce, gasLimit, and gasPrice from an account wallet.
+func MakeTransactOptsWithWallet(wallet accounts.Wallet, from common.Address, nonce *big.Int, chainID *big.Int, gasLimit uint64, gasPrice *big.Int) *TransactOpts {
+	if wallet == nil {
+		return nil
+	}
+
+	auth := NewWalletTransactor(from, wallet, chainID)
+	auth.GasLimit = gasLimit
+	auth.GasPrice = gasPrice
+	auth.Nonce = nonce
+	return auth
+}
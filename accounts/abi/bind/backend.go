@@ -42,6 +42,10 @@ var (
 	// This error is returned by WaitDeployed if contract creation leaves an
 	// empty contract behind.
 	ErrNoCodeAfterDeploy = errors.New("no contract code after deployment")
+
+	// ErrNoFeePayerSigner is returned by transact operations of fee-delegated
+	// transactions if the fee payer signer is not given.
+	ErrNoFeePayerSigner = errors.New("no fee payer signer to authorize the transaction with")
 )
 
 // ContractCaller defines the methods needed to allow operating with contract on a read
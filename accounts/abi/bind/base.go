@@ -30,6 +30,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/params"
 	"math/big"
 )
 
@@ -56,9 +57,28 @@ type TransactOpts struct {
 	GasPrice *big.Int // Gas price to use for the transaction execution (nil = gas price oracle)
 	GasLimit uint64   // Gas limit to set for the transaction execution (0 = estimate)
 
+	// Klaytn transaction types. If SmartContractTx is set, contracts are deployed and invoked
+	// by TxTypeSmartContractDeploy and TxTypeSmartContractExecution instead of legacy transactions,
+	// and funds are transferred to accounts without code by TxTypeValueTransfer.
+	// If FeePayer is set, their fee-delegated variants are used and signed by FeePayerSigner after Signer.
+	SmartContractTx bool
+	FeePayer        common.Address // Account paying the transaction fee (zero = not fee-delegated)
+	FeeRatio        types.FeeRatio // Ratio of the fee paid by FeePayer in percentage (0 = all of the fee)
+	FeePayerSigner  SignerFn       // Method to use for signing the transaction as FeePayer
+
 	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
 }
 
+// isFeeDelegated returns true if the transaction should be paid by the fee payer.
+func (opts *TransactOpts) isFeeDelegated() bool {
+	return opts.FeePayer != (common.Address{})
+}
+
+// isKlaytnTx returns true if the transaction should be sent as a Klaytn transaction type.
+func (opts *TransactOpts) isKlaytnTx() bool {
+	return opts.SmartContractTx || opts.isFeeDelegated()
+}
+
 // FilterOpts is the collection of options to fine tune filtering for events
 // within a bound contract.
 type FilterOpts struct {
@@ -183,6 +203,58 @@ func (c *BoundContract) Transfer(opts *TransactOpts) (*types.Transaction, error)
 	return c.transact(opts, &c.address, nil)
 }
 
+// newKlaytnTx creates a transaction of the given basic type, replaced by its fee-delegated
+// variant decided by the fee delegation options.
+func newKlaytnTx(opts *TransactOpts, txType types.TxType, contract *common.Address, nonce uint64, value *big.Int, gasLimit uint64, gasPrice *big.Int, input []byte) (*types.Transaction, error) {
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    nonce,
+		types.TxValueKeyFrom:     opts.From,
+		types.TxValueKeyAmount:   value,
+		types.TxValueKeyGasLimit: gasLimit,
+		types.TxValueKeyGasPrice: gasPrice,
+	}
+	switch txType {
+	case types.TxTypeSmartContractDeploy:
+		values[types.TxValueKeyTo] = (*common.Address)(nil)
+		values[types.TxValueKeyHumanReadable] = false
+		values[types.TxValueKeyCodeFormat] = params.CodeFormatEVM
+		values[types.TxValueKeyData] = input
+	case types.TxTypeValueTransfer:
+		values[types.TxValueKeyTo] = *contract
+	default:
+		values[types.TxValueKeyTo] = *contract
+		values[types.TxValueKeyData] = input
+	}
+	if opts.isFeeDelegated() {
+		txType++
+		values[types.TxValueKeyFeePayer] = opts.FeePayer
+		if opts.FeeRatio != 0 {
+			txType++
+			values[types.TxValueKeyFeeRatioOfFeePayer] = opts.FeeRatio
+		}
+	}
+	return types.NewTransactionWithMap(txType, values)
+}
+
+// klaytnTxGas replaces the intrinsic gas of a legacy transaction included in the estimated gas
+// with the intrinsic gas of the Klaytn transaction type.
+func klaytnTxGas(opts *TransactOpts, txType types.TxType, contract *common.Address, nonce uint64, value *big.Int, estimated uint64, gasPrice *big.Int, input []byte) (uint64, error) {
+	tx, err := newKlaytnTx(opts, txType, contract, nonce, value, 0, gasPrice, input)
+	if err != nil {
+		return 0, err
+	}
+	// The block number only matters for the transactions creating account keys
+	intrinsic, err := tx.IntrinsicGas(0)
+	if err != nil {
+		return 0, err
+	}
+	legacy, err := types.IntrinsicGas(input, contract == nil, true)
+	if err != nil {
+		return 0, err
+	}
+	return estimated + intrinsic - legacy, nil
+}
+
 // transact executes an actual transaction invocation, first deriving any missing
 // authorization fields, and then scheduling the transaction for execution.
 func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, input []byte) (*types.Transaction, error) {
@@ -206,6 +278,20 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	} else {
 		nonce = opts.Nonce.Uint64()
 	}
+	// Decide the Klaytn transaction type, as funds can only be moved to an account
+	// without code by a value transfer transaction
+	txType := types.TxTypeSmartContractExecution
+	if contract == nil {
+		txType = types.TxTypeSmartContractDeploy
+	} else if opts.isKlaytnTx() && len(input) == 0 {
+		code, err := c.transactor.PendingCodeAt(ensureContext(opts.Context), c.address)
+		if err != nil {
+			return nil, err
+		}
+		if len(code) == 0 {
+			txType = types.TxTypeValueTransfer
+		}
+	}
 	// Figure out the gas allowance and gas price values
 	gasPrice := opts.GasPrice
 	if gasPrice == nil {
@@ -217,7 +303,7 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	gasLimit := opts.GasLimit
 	if gasLimit == 0 {
 		// Gas estimation cannot succeed without code for method invocations
-		if contract != nil {
+		if contract != nil && txType != types.TxTypeValueTransfer {
 			if code, err := c.transactor.PendingCodeAt(ensureContext(opts.Context), c.address); err != nil {
 				return nil, err
 			} else if len(code) == 0 {
@@ -230,10 +316,20 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas needed: %v", err)
 		}
+		// The estimation is based on the intrinsic gas of a legacy transaction
+		if opts.isKlaytnTx() {
+			if gasLimit, err = klaytnTxGas(opts, txType, contract, nonce, value, gasLimit, gasPrice, input); err != nil {
+				return nil, err
+			}
+		}
 	}
 	// Create the transaction, sign it and schedule it for execution
 	var rawTx *types.Transaction
-	if contract == nil {
+	if opts.isKlaytnTx() {
+		if rawTx, err = newKlaytnTx(opts, txType, contract, nonce, value, gasLimit, gasPrice, input); err != nil {
+			return nil, err
+		}
+	} else if contract == nil {
 		rawTx = types.NewContractCreation(nonce, value, gasLimit, gasPrice, input)
 	} else {
 		rawTx = types.NewTransaction(nonce, c.address, value, gasLimit, gasPrice, input)
@@ -241,6 +337,9 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if opts.Signer == nil {
 		return nil, errors.New("no signer to authorize the transaction with")
 	}
+	if opts.isFeeDelegated() && opts.FeePayerSigner == nil {
+		return nil, ErrNoFeePayerSigner
+	}
 
 	chainId, err := c.transactor.ChainID(ensureContext(opts.Context))
 	if err != nil {
@@ -252,6 +351,11 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if err != nil {
 		return nil, err
 	}
+	if opts.isFeeDelegated() {
+		if signedTx, err = opts.FeePayerSigner(signer, opts.FeePayer, signedTx); err != nil {
+			return nil, err
+		}
+	}
 	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx); err != nil {
 		return nil, err
 	}
@@ -25,7 +25,13 @@ import (
 	"github.com/klaytn/klaytn"
 	"github.com/klaytn/klaytn/accounts/abi"
 	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/accounts/abi/bind/backends"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
 	"math/big"
 	"testing"
 )
@@ -81,3 +87,91 @@ func TestPassingBlockNumber(t *testing.T) {
 		t.Fatalf("CodeAt() was passed a block number when it should not have been")
 	}
 }
+
+func TestTransactFeeDelegated(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	feePayerKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	feePayer := crypto.PubkeyToAddress(feePayerKey.PublicKey)
+
+	balance := big.NewInt(params.KLAY)
+	backend := backends.NewSimulatedBackend(blockchain.GenesisAlloc{
+		sender:   {Balance: balance},
+		feePayer: {Balance: balance},
+	})
+	ctx := context.Background()
+
+	// The fee payer signer is mandatory for the fee-delegated transactions.
+	opts := bind.NewKeyedTransactor(senderKey)
+	opts.FeePayer = feePayer
+	_, _, _, err := bind.DeployContract(opts, abi.ABI{}, common.FromHex(waitDeployedTests["successful deploy"].code), backend)
+	assert.Equal(t, bind.ErrNoFeePayerSigner, err)
+
+	// The fee of the deploy is paid by the fee payer.
+	opts.FeePayerSigner = bind.NewKeyedFeePayerSigner(feePayerKey)
+	address, tx, contract, err := bind.DeployContract(opts, abi.ABI{}, common.FromHex(waitDeployedTests["successful deploy"].code), backend)
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedSmartContractDeploy, tx.Type())
+	backend.Commit()
+
+	receipt, err := backend.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	code, err := backend.CodeAt(ctx, address, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, len(code))
+
+	senderBalance, _ := backend.BalanceAt(ctx, sender, nil)
+	feePayerBalance, _ := backend.BalanceAt(ctx, feePayer, nil)
+	assert.Equal(t, balance, senderBalance)
+	assert.Equal(t, new(big.Int).Sub(balance, fee(receipt, tx)), feePayerBalance)
+
+	// The fee of the execution is shared by the fee ratio.
+	opts.FeeRatio = 30
+	tx, err = contract.Transfer(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, tx.Type())
+	backend.Commit()
+
+	receipt, err = backend.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	feePayerFee := new(big.Int).Div(new(big.Int).Mul(fee(receipt, tx), big.NewInt(30)), big.NewInt(100))
+	senderFee := new(big.Int).Sub(fee(receipt, tx), feePayerFee)
+	newSenderBalance, _ := backend.BalanceAt(ctx, sender, nil)
+	newFeePayerBalance, _ := backend.BalanceAt(ctx, feePayer, nil)
+	assert.Equal(t, new(big.Int).Sub(senderBalance, senderFee), newSenderBalance)
+	assert.Equal(t, new(big.Int).Sub(feePayerBalance, feePayerFee), newFeePayerBalance)
+
+	// Without the fee payer, the sender pays the fee of the smart contract execution.
+	opts = bind.NewKeyedTransactor(senderKey)
+	opts.SmartContractTx = true
+	tx, err = contract.Transfer(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeSmartContractExecution, tx.Type())
+	backend.Commit()
+
+	receipt, err = backend.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// Funds are moved to an account without code by a value transfer.
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	account := bind.NewBoundContract(recipient, abi.ABI{}, backend, backend, backend)
+	opts.Value = big.NewInt(1)
+	tx, err = account.Transfer(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeValueTransfer, tx.Type())
+	backend.Commit()
+
+	receipt, err = backend.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	recipientBalance, _ := backend.BalanceAt(ctx, recipient, nil)
+	assert.Equal(t, big.NewInt(1), recipientBalance)
+}
+
+func fee(receipt *types.Receipt, tx *types.Transaction) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), tx.GasPrice())
+}
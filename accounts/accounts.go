@@ -128,6 +128,14 @@ type Wallet interface {
 	// It looks up the account specified either solely via its address contained within,
 	// or optionally with the aid of any location metadata from the embedded URL field.
 	SignTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// SignTxAsFeePayer requests the wallet to sign the given fee-delegated transaction
+	// as a fee payer. It works like SignTx except that the fee payer signatures are set.
+	SignTxAsFeePayer(account Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// SignTxAsFeePayerWithPassphrase requests the wallet to sign the given fee-delegated
+	// transaction as a fee payer, with the given passphrase as extra authentication information.
+	SignTxAsFeePayerWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 }
 
 // Backend is a "wallet provider" that may contain a batch of accounts they can
@@ -0,0 +1,194 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package external
+
+import (
+	"errors"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"math/big"
+)
+
+// Version is the version of the signing protocol served by SignerAPI.
+const Version = "1.0.0"
+
+var (
+	ErrRequestRejected = errors.New("request rejected")
+	ErrNoChainID       = errors.New("chain ID is not given")
+	ErrFromMismatch    = errors.New("the account does not match the transaction")
+)
+
+var logger = log.NewModuleLogger(log.AccountsExternal)
+
+// RequestKind is the kind of a signing request.
+type RequestKind int
+
+const (
+	SignTxRequest RequestKind = iota
+	SignTxAsFeePayerRequest
+	SignHashRequest
+)
+
+func (k RequestKind) String() string {
+	switch k {
+	case SignTxRequest:
+		return "SignTx"
+	case SignTxAsFeePayerRequest:
+		return "SignTxAsFeePayer"
+	case SignHashRequest:
+		return "SignHash"
+	default:
+		return "Unknown"
+	}
+}
+
+// SignTxArgs is the argument of the transaction signing requests.
+// The transaction is delivered in the RLP encoding.
+type SignTxArgs struct {
+	From    common.Address `json:"from"`
+	Tx      hexutil.Bytes  `json:"tx"`
+	ChainID *hexutil.Big   `json:"chainId"`
+}
+
+// SignRequest is a signing request to be approved before the signer signs.
+// Tx and ChainID are set for the transaction signing requests, and Hash is set
+// for the hash signing requests.
+type SignRequest struct {
+	Kind    RequestKind
+	From    common.Address
+	Tx      *types.Transaction
+	ChainID *big.Int
+	Hash    []byte
+}
+
+// Approver decides whether a signing request is signed or not.
+// It returns an error if the request is rejected.
+type Approver interface {
+	Approve(req *SignRequest) error
+}
+
+// SignerAPI is the "account" namespace served by an external signer. It signs the
+// approved requests with the unlocked accounts of the keystore.
+type SignerAPI struct {
+	ks       *keystore.KeyStore
+	approver Approver
+}
+
+// NewSignerAPI creates a signer API signing with the keystore. The requests are
+// signed only if they are approved by the approver.
+func NewSignerAPI(ks *keystore.KeyStore, approver Approver) *SignerAPI {
+	return &SignerAPI{ks: ks, approver: approver}
+}
+
+// Version returns the version of the signing protocol.
+func (api *SignerAPI) Version() string {
+	return Version
+}
+
+// List returns the addresses of the accounts in the keystore.
+func (api *SignerAPI) List() []common.Address {
+	accs := api.ks.Accounts()
+	addrs := make([]common.Address, len(accs))
+	for i, acc := range accs {
+		addrs[i] = acc.Address
+	}
+	return addrs
+}
+
+// SignTransaction signs the RLP-encoded transaction as a sender, and returns the
+// RLP-encoded signed transaction.
+func (api *SignerAPI) SignTransaction(args SignTxArgs) (hexutil.Bytes, error) {
+	req, err := newSignTxRequest(SignTxRequest, args)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Tx.IsLegacyTransaction() {
+		if from, err := req.Tx.From(); err != nil || from != req.From {
+			return nil, ErrFromMismatch
+		}
+	}
+	if err := api.approve(req); err != nil {
+		return nil, err
+	}
+	signed, err := api.ks.SignTx(accounts.Account{Address: req.From}, req.Tx, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(signed)
+}
+
+// SignTransactionAsFeePayer signs the RLP-encoded fee-delegated transaction as a fee payer,
+// and returns the RLP-encoded signed transaction.
+func (api *SignerAPI) SignTransactionAsFeePayer(args SignTxArgs) (hexutil.Bytes, error) {
+	req, err := newSignTxRequest(SignTxAsFeePayerRequest, args)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Tx.IsFeeDelegatedTransaction() {
+		return nil, accounts.ErrNotSupported
+	}
+	if feePayer, err := req.Tx.FeePayer(); err != nil || feePayer != req.From {
+		return nil, ErrFromMismatch
+	}
+	if err := api.approve(req); err != nil {
+		return nil, err
+	}
+	signed, err := api.ks.SignTxAsFeePayer(accounts.Account{Address: req.From}, req.Tx, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(signed)
+}
+
+// SignHash signs the hash with the account.
+func (api *SignerAPI) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	req := &SignRequest{Kind: SignHashRequest, From: address, Hash: hash}
+	if err := api.approve(req); err != nil {
+		return nil, err
+	}
+	return api.ks.SignHash(accounts.Account{Address: address}, hash)
+}
+
+func (api *SignerAPI) approve(req *SignRequest) error {
+	if err := api.approver.Approve(req); err != nil {
+		logger.Warn("Rejected the signing request", "kind", req.Kind, "from", req.From, "err", err)
+		return err
+	}
+	logger.Info("Approved the signing request", "kind", req.Kind, "from", req.From)
+	return nil
+}
+
+func newSignTxRequest(kind RequestKind, args SignTxArgs) (*SignRequest, error) {
+	if args.ChainID == nil {
+		return nil, ErrNoChainID
+	}
+	tx, err := types.DecodeUnsignedTx(args.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return &SignRequest{
+		Kind:    kind,
+		From:    args.From,
+		Tx:      tx,
+		ChainID: args.ChainID.ToInt(),
+	}, nil
+}
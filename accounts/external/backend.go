@@ -0,0 +1,278 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package external implements an account backend forwarding the signing requests
+// to an external signer process, so that the node does not need to hold private keys.
+package external
+
+import (
+	"errors"
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Scheme is the protocol scheme prefixing external signer URLs.
+const Scheme = "extapi"
+
+// accountsRefreshInterval is how long the accounts of the external signer are cached.
+const accountsRefreshInterval = 10 * time.Second
+
+var (
+	ErrSignedTxMismatch = errors.New("the signed transaction does not match the requested transaction")
+	ErrSignerMismatch   = errors.New("the transaction is not signed by the requested account")
+)
+
+// BackendType is the reflect type of an external signer backend.
+var BackendType = reflect.TypeOf(&ExternalBackend{})
+
+// ExternalBackend is an accounts.Backend which has a single wallet of an external signer.
+type ExternalBackend struct {
+	signers []accounts.Wallet
+}
+
+// NewExternalBackend connects to the external signer listening on the given endpoint,
+// which is an IPC path or an HTTP URL.
+func NewExternalBackend(endpoint string) (*ExternalBackend, error) {
+	signer, err := NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &ExternalBackend{signers: []accounts.Wallet{signer}}, nil
+}
+
+// Wallets implements accounts.Backend, returning the wallet of the external signer.
+func (eb *ExternalBackend) Wallets() []accounts.Wallet {
+	return eb.signers
+}
+
+// Subscribe implements accounts.Backend. The wallet of an external signer never
+// arrives or departs, so no event is sent.
+func (eb *ExternalBackend) Subscribe(sink chan<- accounts.WalletEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+// ExternalSigner is an accounts.Wallet forwarding the signing requests to an external
+// signer through the "account" namespace of JSON-RPC. The signer may reject a request
+// by its rules or by the decision of its operator.
+type ExternalSigner struct {
+	client   *rpc.Client
+	endpoint string
+
+	cache     []accounts.Account
+	cacheTime time.Time
+	mu        sync.RWMutex
+}
+
+// NewExternalSigner returns a wallet of the external signer listening on the given endpoint.
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &ExternalSigner{client: client, endpoint: endpoint}, nil
+}
+
+// URL implements accounts.Wallet, returning the URL of the external signer.
+func (es *ExternalSigner) URL() accounts.URL {
+	return accounts.URL{Scheme: Scheme, Path: es.endpoint}
+}
+
+// Status implements accounts.Wallet, returning the version of the external signer.
+func (es *ExternalSigner) Status() (string, error) {
+	var version string
+	if err := es.client.Call(&version, "account_version"); err != nil {
+		return "Failed", err
+	}
+	return "Ok [version=" + version + "]", nil
+}
+
+// Open implements accounts.Wallet. The connection is made by NewExternalSigner, so it does nothing.
+func (es *ExternalSigner) Open(passphrase string) error {
+	return nil
+}
+
+// Close implements accounts.Wallet, closing the connection to the external signer.
+func (es *ExternalSigner) Close() error {
+	es.client.Close()
+	return nil
+}
+
+// Accounts implements accounts.Wallet, returning the accounts the external signer can sign with.
+// The list is cached for accountsRefreshInterval after it is retrieved successfully.
+func (es *ExternalSigner) Accounts() []accounts.Account {
+	es.mu.RLock()
+	cached, cacheTime := es.cache, es.cacheTime
+	es.mu.RUnlock()
+	if cached != nil && time.Since(cacheTime) < accountsRefreshInterval {
+		return cached
+	}
+
+	var addrs []common.Address
+	if err := es.client.Call(&addrs, "account_list"); err != nil {
+		logger.Warn("Failed to list the accounts of the external signer", "endpoint", es.endpoint, "err", err)
+		if cached != nil {
+			return cached
+		}
+		return []accounts.Account{}
+	}
+	list := make([]accounts.Account, len(addrs))
+	for i, addr := range addrs {
+		list[i] = accounts.Account{Address: addr, URL: es.URL()}
+	}
+
+	es.mu.Lock()
+	es.cache, es.cacheTime = list, time.Now()
+	es.mu.Unlock()
+	return list
+}
+
+// Contains implements accounts.Wallet, returning whether the external signer has the account.
+func (es *ExternalSigner) Contains(account accounts.Account) bool {
+	for _, acc := range es.Accounts() {
+		if acc.Address == account.Address {
+			return true
+		}
+	}
+	return false
+}
+
+// Derive implements accounts.Wallet, but is not supported by the external signer.
+func (es *ExternalSigner) Derive(path accounts.DerivationPath, pin bool) (accounts.Account, error) {
+	return accounts.Account{}, accounts.ErrNotSupported
+}
+
+// SelfDerive implements accounts.Wallet, but is not supported by the external signer.
+func (es *ExternalSigner) SelfDerive(base accounts.DerivationPath, chain klaytn.ChainReader) {
+	logger.Error("Self derivation is not supported by the external signer")
+}
+
+// SignHash implements accounts.Wallet, requesting the external signer to sign the hash.
+func (es *ExternalSigner) SignHash(account accounts.Account, hash []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	if err := es.client.Call(&sig, "account_signHash", account.Address, hexutil.Bytes(hash)); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// SignTx implements accounts.Wallet, requesting the external signer to sign the transaction as a sender.
+func (es *ExternalSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := es.signTx("account_signTransaction", account, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.NewEIP155Signer(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, ErrSignedTxMismatch
+	}
+	// The signature of a legacy transaction recovers to the sender. The signatures of the
+	// other transactions are checked to be recoverable here, and checked against the
+	// account key of the sender, which may be decoupled from its address, when the
+	// transaction is submitted.
+	if signed.IsLegacyTransaction() {
+		if from, err := types.Sender(signer, signed); err != nil || from != account.Address {
+			return nil, ErrSignerMismatch
+		}
+		return signed, nil
+	}
+	if _, err := signer.SenderPubkey(signed); err != nil {
+		return nil, ErrSignerMismatch
+	}
+	if from, err := signed.From(); err != nil || from != account.Address {
+		return nil, ErrSignerMismatch
+	}
+	return signed, nil
+}
+
+// SignTxAsFeePayer implements accounts.Wallet, requesting the external signer to sign the
+// fee-delegated transaction as a fee payer.
+func (es *ExternalSigner) SignTxAsFeePayer(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := es.signTx("account_signTransactionAsFeePayer", account, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.NewEIP155Signer(chainID)
+	signedHash, err := signer.HashFeePayer(signed)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := signer.HashFeePayer(tx)
+	if err != nil {
+		return nil, err
+	}
+	if signedHash != hash {
+		return nil, ErrSignedTxMismatch
+	}
+	if _, err := signer.SenderFeePayer(signed); err != nil {
+		return nil, ErrSignerMismatch
+	}
+	if feePayer, err := signed.FeePayer(); err != nil || feePayer != account.Address {
+		return nil, ErrSignerMismatch
+	}
+	return signed, nil
+}
+
+// SignHashWithPassphrase implements accounts.Wallet, but is not supported because
+// the passphrases are managed by the external signer.
+func (es *ExternalSigner) SignHashWithPassphrase(account accounts.Account, passphrase string, hash []byte) ([]byte, error) {
+	return nil, accounts.ErrNotSupported
+}
+
+// SignTxWithPassphrase implements accounts.Wallet, but is not supported because
+// the passphrases are managed by the external signer.
+func (es *ExternalSigner) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, accounts.ErrNotSupported
+}
+
+// SignTxAsFeePayerWithPassphrase implements accounts.Wallet, but is not supported because
+// the passphrases are managed by the external signer.
+func (es *ExternalSigner) SignTxAsFeePayerWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, accounts.ErrNotSupported
+}
+
+func (es *ExternalSigner) signTx(method string, account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	encoded, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	args := &SignTxArgs{
+		From:    account.Address,
+		Tx:      encoded,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	var res hexutil.Bytes
+	if err := es.client.Call(&res, method, args); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err := rlp.DecodeBytes(res, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
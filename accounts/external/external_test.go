@@ -0,0 +1,255 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package external
+
+import (
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestSigner starts a signer serving the accounts of a new keystore over IPC,
+// and returns the wallet connected to it.
+func newTestSigner(t *testing.T, rules func(sender, feePayer common.Address) Rules) (accounts.Wallet, common.Address, common.Address, func()) {
+	dir, err := ioutil.TempDir("", "klaytn-external-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(filepath.Join(dir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	var addrs []common.Address
+	for i := 0; i < 2; i++ {
+		acc, err := ks.NewAccount("foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ks.Unlock(acc, "foo"); err != nil {
+			t.Fatal(err)
+		}
+		addrs = append(addrs, acc.Address)
+	}
+
+	endpoint := filepath.Join(dir, "ksigner.ipc")
+	apis := []rpc.API{{Namespace: "account", Version: "1.0", Service: NewSignerAPI(ks, rules(addrs[0], addrs[1]))}}
+	listener, server, err := rpc.StartIPCEndpoint(endpoint, apis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend, err := NewExternalBackend(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallets := backend.Wallets()
+	if len(wallets) != 1 {
+		t.Fatalf("wallet count mismatch: have %d, want 1", len(wallets))
+	}
+	return wallets[0], addrs[0], addrs[1], func() {
+		wallets[0].Close()
+		server.Stop()
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestExternalSigner(t *testing.T) {
+	allowed := common.HexToAddress("0x1")
+	wallet, sender, feePayer, closeFn := newTestSigner(t, func(sender, feePayer common.Address) Rules {
+		return Rules{
+			sender:   {AllowedRecipients: []common.Address{allowed}, MaxValue: big.NewInt(100)},
+			feePayer: {AllowFeePayer: true, MaxFee: big.NewInt(100000 * 25), AllowSignHash: true},
+		}
+	})
+	defer closeFn()
+
+	if !wallet.Contains(accounts.Account{Address: sender}) || !wallet.Contains(accounts.Account{Address: feePayer}) {
+		t.Fatal("the accounts of the signer are not listed")
+	}
+	if status, err := wallet.Status(); err != nil {
+		t.Fatalf("failed to get the status: %v (%v)", err, status)
+	}
+
+	chainID := big.NewInt(1000)
+	signer := types.NewEIP155Signer(chainID)
+
+	// A legacy transaction within the rule is signed.
+	tx := types.NewTransaction(0, allowed, big.NewInt(100), 21000, big.NewInt(25), nil)
+	signed, err := wallet.SignTx(accounts.Account{Address: sender}, tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, err := types.Sender(signer, signed); err != nil || from != sender {
+		t.Fatalf("sender mismatch: have %x, want %x (%v)", from, sender, err)
+	}
+
+	// The transactions to other recipients or over the value cap are rejected.
+	tx = types.NewTransaction(0, common.HexToAddress("0x2"), big.NewInt(1), 21000, big.NewInt(25), nil)
+	if _, err := wallet.SignTx(accounts.Account{Address: sender}, tx, chainID); err == nil {
+		t.Error("the transaction to the other recipient should be rejected")
+	}
+	tx = types.NewTransaction(0, allowed, big.NewInt(101), 21000, big.NewInt(25), nil)
+	if _, err := wallet.SignTx(accounts.Account{Address: sender}, tx, chainID); err == nil {
+		t.Error("the transaction over the value cap should be rejected")
+	}
+
+	// A fee-delegated transaction is signed by the sender and the fee payer.
+	tx, err = types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyFrom:     sender,
+		types.TxValueKeyTo:       allowed,
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyFeePayer: feePayer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.SignTxAsFeePayer(accounts.Account{Address: sender}, tx, chainID); err == nil {
+		t.Error("the sender is not allowed to sign as a fee payer")
+	}
+	tx, err = wallet.SignTx(accounts.Account{Address: sender}, tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = wallet.SignTxAsFeePayer(accounts.Account{Address: feePayer}, tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubs, err := signer.SenderFeePayer(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubs) != 1 || crypto.PubkeyToAddress(*pubs[0]) != feePayer {
+		t.Error("the transaction is not signed by the fee payer")
+	}
+
+	// The sender of the transaction should be the requested account.
+	if _, err := wallet.SignTx(accounts.Account{Address: feePayer}, tx, chainID); err == nil {
+		t.Error("the transaction of the other sender should be rejected")
+	}
+
+	// Signing a hash follows the rule.
+	hash := crypto.Keccak256([]byte("hello"))
+	if _, err := wallet.SignHash(accounts.Account{Address: sender}, hash); err == nil {
+		t.Error("the sender is not allowed to sign a hash")
+	}
+	sig, err := wallet.SignHash(accounts.Account{Address: feePayer}, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil || crypto.PubkeyToAddress(*pub) != feePayer {
+		t.Errorf("the hash is not signed by the fee payer (%v)", err)
+	}
+
+	// The passphrases are managed by the signer.
+	if _, err := wallet.SignTxWithPassphrase(accounts.Account{Address: sender}, "foo", tx, chainID); err != accounts.ErrNotSupported {
+		t.Errorf("wrong error: have %v, want %v", err, accounts.ErrNotSupported)
+	}
+}
+
+func TestExternalSignerNoRule(t *testing.T) {
+	wallet, sender, _, closeFn := newTestSigner(t, func(sender, feePayer common.Address) Rules {
+		return Rules{}
+	})
+	defer closeFn()
+
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(0), 21000, big.NewInt(25), nil)
+	if _, err := wallet.SignTx(accounts.Account{Address: sender}, tx, big.NewInt(1)); err == nil {
+		t.Error("the account without a rule should be rejected")
+	}
+}
+
+// WrongKeySignerAPI is a signer which signs the transactions with a key of another account.
+type WrongKeySignerAPI struct {
+	addrs []common.Address
+}
+
+func (api *WrongKeySignerAPI) List() []common.Address {
+	return api.addrs
+}
+
+func (api *WrongKeySignerAPI) SignTransaction(args SignTxArgs) (hexutil.Bytes, error) {
+	tx, err := types.DecodeUnsignedTx(args.Tx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := types.SignTx(tx, types.NewEIP155Signer(args.ChainID.ToInt()), key)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(signed)
+}
+
+func TestExternalSignerWrongKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "klaytn-external-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	account := accounts.Account{Address: common.HexToAddress("0x1234")}
+	api := &WrongKeySignerAPI{addrs: []common.Address{account.Address}}
+	endpoint := filepath.Join(dir, "ksigner.ipc")
+	listener, server, err := rpc.StartIPCEndpoint(endpoint, []rpc.API{{Namespace: "account", Version: "1.0", Service: api}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	defer server.Stop()
+	wallet, err := NewExternalSigner(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wallet.Close()
+
+	// The transaction signed by the key of another account is rejected.
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(25), nil)
+	if _, err := wallet.SignTx(account, tx, big.NewInt(1)); err != ErrSignerMismatch {
+		t.Errorf("wrong error: have %v, want %v", err, ErrSignerMismatch)
+	}
+
+	// The accounts are listed again after the cache expires.
+	if !wallet.Contains(account) {
+		t.Fatal("the account of the signer is not listed")
+	}
+	other := accounts.Account{Address: common.HexToAddress("0x5678")}
+	api.addrs = append(api.addrs, other.Address)
+	if wallet.Contains(other) {
+		t.Fatal("the accounts should be cached")
+	}
+	wallet.mu.Lock()
+	wallet.cacheTime = time.Now().Add(-accountsRefreshInterval)
+	wallet.mu.Unlock()
+	if !wallet.Contains(other) {
+		t.Error("the accounts are not refreshed")
+	}
+}
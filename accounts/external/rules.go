@@ -0,0 +1,115 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/klaytn/klaytn/common"
+	"io/ioutil"
+	"math/big"
+)
+
+// AccountRule is the rule of the requests signed with an account.
+// A request is rejected unless it satisfies all the conditions of the rule.
+type AccountRule struct {
+	// AllowedRecipients is the list of the recipients of the transactions.
+	// If it is empty, the transactions to any recipient are allowed.
+	AllowedRecipients []common.Address `json:"allowedRecipients"`
+
+	// AllowNoRecipient allows the transactions without a recipient, such as
+	// contract deployments and account updates.
+	AllowNoRecipient bool `json:"allowNoRecipient"`
+
+	// MaxValue is the maximum value transferred by a transaction. No limit if nil.
+	MaxValue *big.Int `json:"maxValue"`
+
+	// AllowFeePayer allows signing fee-delegated transactions as a fee payer.
+	AllowFeePayer bool `json:"allowFeePayer"`
+
+	// MaxFee is the maximum fee (gas limit * gas price) of a transaction. No limit if nil.
+	MaxFee *big.Int `json:"maxFee"`
+
+	// AllowSignHash allows signing arbitrary hashes. The signer cannot tell what a hash
+	// is signed for, and a hash may be the hash of any transaction, so the other conditions
+	// of the rule are not applied to the hashes. Allow it only for the fully trusted callers.
+	AllowSignHash bool `json:"allowSignHash"`
+}
+
+// Rules is an Approver with the rules of the accounts.
+// The requests of an account without a rule are rejected.
+type Rules map[common.Address]*AccountRule
+
+// LoadRules reads the rules from a JSON file, which is an object from the account
+// addresses to their rules.
+func LoadRules(file string) (Rules, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	rules := make(Rules)
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Approve implements Approver, checking the request with the rule of the account.
+func (rules Rules) Approve(req *SignRequest) error {
+	rule, ok := rules[req.From]
+	if !ok || rule == nil {
+		return fmt.Errorf("%v: no rule for the account %v", ErrRequestRejected, req.From.String())
+	}
+
+	switch req.Kind {
+	case SignHashRequest:
+		// The other conditions cannot be applied to a hash, see AllowSignHash.
+		if !rule.AllowSignHash {
+			return fmt.Errorf("%v: signing a hash is not allowed", ErrRequestRejected)
+		}
+		return nil
+	case SignTxAsFeePayerRequest:
+		if !rule.AllowFeePayer {
+			return fmt.Errorf("%v: signing as a fee payer is not allowed", ErrRequestRejected)
+		}
+	}
+
+	tx := req.Tx
+	if to := tx.To(); to == nil {
+		if !rule.AllowNoRecipient {
+			return fmt.Errorf("%v: a transaction without a recipient is not allowed", ErrRequestRejected)
+		}
+	} else if len(rule.AllowedRecipients) > 0 && !containsAddress(rule.AllowedRecipients, *to) {
+		return fmt.Errorf("%v: the recipient %v is not allowed", ErrRequestRejected, to.String())
+	}
+	if rule.MaxValue != nil && tx.Value().Cmp(rule.MaxValue) > 0 {
+		return fmt.Errorf("%v: the value %v exceeds the cap %v", ErrRequestRejected, tx.Value(), rule.MaxValue)
+	}
+	if rule.MaxFee != nil && tx.Fee().Cmp(rule.MaxFee) > 0 {
+		return fmt.Errorf("%v: the fee %v exceeds the cap %v", ErrRequestRejected, tx.Fee(), rule.MaxFee)
+	}
+	return nil
+}
+
+func containsAddress(list []common.Address, addr common.Address) bool {
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
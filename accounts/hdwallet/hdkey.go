@@ -0,0 +1,128 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/math"
+	"github.com/klaytn/klaytn/crypto"
+	"math/big"
+)
+
+// hardenedKeyStart is the index of the first hardened child key in BIP-32.
+const hardenedKeyStart = 0x80000000
+
+var (
+	errInvalidSeedLength = errors.New("the seed length should be between 128 and 512 bits")
+	errInvalidChildKey   = errors.New("the derived key is invalid for the index")
+)
+
+// masterKeySalt is the HMAC key to generate the master key from a seed, defined in BIP-32.
+var masterKeySalt = []byte("Bitcoin seed")
+
+// extendedKey is a BIP-32 extended private key of secp256k1.
+type extendedKey struct {
+	key       []byte // 32 bytes private key
+	chainCode []byte
+}
+
+// newMasterKey returns the master extended key of the given seed.
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, errInvalidSeedLength
+	}
+	mac := hmac.New(sha512.New, masterKeySalt)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	k := new(big.Int).SetBytes(sum[:32])
+	if k.Sign() == 0 || k.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, errInvalidChildKey
+	}
+	return &extendedKey{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+// child returns the child extended key of the given index.
+// The index at or above hardenedKeyStart derives a hardened child.
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	data := make([]byte, 0, 37)
+	if index >= hardenedKeyStart {
+		data = append(data, 0x0)
+		data = append(data, k.key...)
+	} else {
+		priv, err := crypto.ToECDSA(k.key)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, crypto.CompressPubkey(&priv.PublicKey)...)
+	}
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], index)
+	data = append(data, indexBytes[:]...)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(n) >= 0 {
+		return nil, errInvalidChildKey
+	}
+	childKey := il.Add(il, new(big.Int).SetBytes(k.key))
+	childKey.Mod(childKey, n)
+	if childKey.Sign() == 0 {
+		return nil, errInvalidChildKey
+	}
+	return &extendedKey{key: math.PaddedBigBytes(childKey, 32), chainCode: sum[32:]}, nil
+}
+
+// derive returns the descendant extended key of the given derivation path.
+// The intermediate keys are cleared, so the caller only has to clear the returned key.
+func (k *extendedKey) derive(path accounts.DerivationPath) (*extendedKey, error) {
+	key := &extendedKey{key: common.CopyBytes(k.key), chainCode: common.CopyBytes(k.chainCode)}
+	for _, index := range path {
+		child, err := key.child(index)
+		key.zero()
+		if err != nil {
+			return nil, err
+		}
+		key = child
+	}
+	return key, nil
+}
+
+// privateKey returns the secp256k1 private key of the extended key.
+func (k *extendedKey) privateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(k.key)
+}
+
+// zero clears the key material in memory.
+func (k *extendedKey) zero() {
+	for i := range k.key {
+		k.key[i] = 0
+	}
+	for i := range k.chainCode {
+		k.chainCode[i] = 0
+	}
+}
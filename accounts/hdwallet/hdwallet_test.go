@@ -0,0 +1,131 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package hdwallet
+
+import (
+	"encoding/hex"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// TestDeriveBIP32Vector checks the derivation with the test vector 1 of BIP-32.
+func TestDeriveBIP32Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := newMasterKey(seed)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", hex.EncodeToString(master.key))
+
+	tests := []struct {
+		path string
+		key  string
+	}{
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{"m/0'/1/2'/2", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{"m/0'/1/2'/2/1000000000", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+	for _, test := range tests {
+		path, err := accounts.ParseDerivationPath(test.path)
+		assert.NoError(t, err)
+		key, err := master.derive(path)
+		if assert.NoError(t, err) {
+			assert.Equal(t, test.key, hex.EncodeToString(key.key), test.path)
+		}
+	}
+	// The master key is not changed by the derivation.
+	assert.Equal(t, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", hex.EncodeToString(master.key))
+}
+
+// TestHubImportMnemonic checks if an HD wallet is stored, reloaded and signs with the derived keys.
+func TestHubImportMnemonic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "klaytn-hdwallet-test")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	hub, err := NewHub(dir, keystore.LightScryptN, keystore.LightScryptP)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, _, err = hub.ImportMnemonic("abandon abandon abandon", "foo")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+
+	w, account, err := hub.ImportMnemonic(testMnemonic, "foo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, _, err = hub.ImportMnemonic(testMnemonic, "bar")
+	assert.Equal(t, ErrWalletExists, err)
+	assert.Equal(t, []accounts.Account{account}, w.Accounts())
+
+	// A derivation needs the seed.
+	ethPath, _ := accounts.ParseDerivationPath("m/44'/60'/0'/0/0")
+	_, err = w.Derive(ethPath, true)
+	assert.Equal(t, accounts.ErrWalletClosed, err)
+	assert.Equal(t, keystore.ErrDecrypt, w.Open("bar"))
+	assert.NoError(t, w.Open("foo"))
+
+	ethAccount, err := w.Derive(ethPath, true)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), ethAccount.Address)
+
+	// The pinned accounts are reloaded from the seed file.
+	reloaded, err := NewHub(dir, keystore.LightScryptN, keystore.LightScryptP)
+	if !assert.NoError(t, err) || !assert.Equal(t, 1, len(reloaded.Wallets())) {
+		return
+	}
+	rw := reloaded.Wallets()[0]
+	assert.Equal(t, []accounts.Account{account, ethAccount}, rw.Accounts())
+	assert.True(t, rw.Contains(accounts.Account{Address: ethAccount.Address}))
+
+	// The transaction is signed with the passphrase while the wallet is closed.
+	chainID := big.NewInt(1)
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(25), nil)
+	_, err = rw.SignTx(ethAccount, tx, chainID)
+	assert.Equal(t, accounts.ErrWalletClosed, err)
+	signed, err := rw.SignTxWithPassphrase(ethAccount, "foo", tx, chainID)
+	if assert.NoError(t, err) {
+		from, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+		assert.NoError(t, err)
+		assert.Equal(t, ethAccount.Address, from)
+	}
+
+	hash := crypto.Keccak256([]byte("hello"))
+	sig, err := w.SignHash(account, hash)
+	if assert.NoError(t, err) {
+		pub, err := crypto.SigToPub(hash, sig)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Address, crypto.PubkeyToAddress(*pub))
+	}
+	assert.NoError(t, w.Close())
+	_, err = w.SignHash(account, hash)
+	assert.Equal(t, accounts.ErrWalletClosed, err)
+}
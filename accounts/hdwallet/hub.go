@@ -0,0 +1,231 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package hdwallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/log"
+	"github.com/pborman/uuid"
+	"github.com/tyler-smith/go-bip39"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+const (
+	// DirName is the name of the directory in the keystore directory where the seed files are stored.
+	DirName = "hdwallet"
+
+	// Scheme is the protocol scheme prefixing HD wallet and account URLs.
+	Scheme = "hd"
+
+	seedFileVersion = 1
+
+	// mnemonicEntropyBits is the entropy size of a new mnemonic, which makes 24 words.
+	mnemonicEntropyBits = 256
+)
+
+var (
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	ErrWalletExists    = errors.New("HD wallet of the mnemonic already exists")
+)
+
+var logger = log.NewModuleLogger(log.AccountsHDWallet)
+
+// HubType is the reflect type of an HD wallet backend.
+var HubType = reflect.TypeOf(&Hub{})
+
+// seedFileJSON is the on-disk format of an HD wallet. The seed is encrypted in the format
+// of the keystore, and the pinned accounts are stored in plaintext to list them without the seed.
+type seedFileJSON struct {
+	Crypto   keystore.CryptoJSON `json:"crypto"`
+	Accounts []seedAccountJSON   `json:"accounts"`
+	Id       string              `json:"id"`
+	Version  int                 `json:"version"`
+}
+
+type seedAccountJSON struct {
+	Address string `json:"address"`
+	Path    string `json:"path"`
+}
+
+// Hub is an accounts.Backend managing HD wallets derived from BIP-39 mnemonics.
+// Each wallet is an encrypted seed file in the directory of the hub.
+type Hub struct {
+	dir     string
+	scryptN int
+	scryptP int
+
+	wallets     []accounts.Wallet       // HD wallets sorted by the URL
+	updateFeed  event.Feed              // Event feed to notify wallet additions/removals
+	updateScope event.SubscriptionScope // Subscription scope tracking current live listeners
+
+	mu sync.RWMutex
+}
+
+// NewHub creates an HD wallet backend loading the seed files in the given directory.
+func NewHub(dir string, scryptN, scryptP int) (*Hub, error) {
+	dir, _ = filepath.Abs(dir)
+	hub := &Hub{dir: dir, scryptN: scryptN, scryptP: scryptP}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, fi := range files {
+		if fi.IsDir() || fi.Name()[0] == '.' {
+			continue
+		}
+		w, err := loadWallet(hub, filepath.Join(dir, fi.Name()))
+		if err != nil {
+			logger.Warn("Failed to load HD wallet", "file", fi.Name(), "err", err)
+			continue
+		}
+		hub.wallets = append(hub.wallets, w)
+	}
+	sortWallets(hub.wallets)
+	return hub, nil
+}
+
+// Wallets implements accounts.Backend, returning all HD wallets of the hub.
+func (h *Hub) Wallets() []accounts.Wallet {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	cpy := make([]accounts.Wallet, len(h.wallets))
+	copy(cpy, h.wallets)
+	return cpy
+}
+
+// Subscribe implements accounts.Backend, creating an async subscription to
+// receive notifications on the addition or opening of HD wallets.
+func (h *Hub) Subscribe(sink chan<- accounts.WalletEvent) event.Subscription {
+	return h.updateScope.Track(h.updateFeed.Subscribe(sink))
+}
+
+// NewMnemonic generates a new BIP-39 mnemonic of 24 words.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// ImportMnemonic stores the seed of the given mnemonic into a new seed file encrypted
+// with the passphrase. The first account of the default derivation path is pinned.
+func (h *Hub) ImportMnemonic(mnemonic, passphrase string) (accounts.Wallet, accounts.Account, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, accounts.Account{}, ErrInvalidMnemonic
+	}
+	master, err := newMasterKey(seed)
+	if err != nil {
+		return nil, accounts.Account{}, err
+	}
+	defer master.zero()
+
+	address, err := deriveAddress(master, accounts.DefaultBaseDerivationPath)
+	if err != nil {
+		return nil, accounts.Account{}, err
+	}
+	h.mu.RLock()
+	for _, w := range h.wallets {
+		if w.(*wallet).path(address) != nil {
+			h.mu.RUnlock()
+			return nil, accounts.Account{}, ErrWalletExists
+		}
+	}
+	h.mu.RUnlock()
+
+	cryptoStruct, err := keystore.EncryptDataV3(seed, passphrase, h.scryptN, h.scryptP)
+	if err != nil {
+		return nil, accounts.Account{}, err
+	}
+	id := uuid.NewRandom()
+	w := newWallet(h, filepath.Join(h.dir, fmt.Sprintf("%s-%s", DirName, id.String())), id.String(), cryptoStruct)
+	account := w.pin(address, accounts.DefaultBaseDerivationPath)
+	if err := w.save(); err != nil {
+		return nil, accounts.Account{}, err
+	}
+
+	h.mu.Lock()
+	h.wallets = append(h.wallets, w)
+	sortWallets(h.wallets)
+	h.mu.Unlock()
+
+	h.updateFeed.Send(accounts.WalletEvent{Wallet: w, Kind: accounts.WalletArrived})
+	return w, account, nil
+}
+
+// loadWallet reads the seed file of an HD wallet.
+func loadWallet(hub *Hub, file string) (*wallet, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	seedFile := new(seedFileJSON)
+	if err := json.Unmarshal(content, seedFile); err != nil {
+		return nil, err
+	}
+	if seedFile.Version != seedFileVersion {
+		return nil, fmt.Errorf("version not supported: %v", seedFile.Version)
+	}
+	w := newWallet(hub, file, seedFile.Id, seedFile.Crypto)
+	for _, acc := range seedFile.Accounts {
+		path, err := accounts.ParseDerivationPath(acc.Path)
+		if err != nil {
+			return nil, err
+		}
+		w.pin(common.HexToAddress(acc.Address), path)
+	}
+	return w, nil
+}
+
+// writeSeedFile atomically writes the content into the file, creating the directory if needed.
+func writeSeedFile(file string, content []byte) error {
+	const dirPerm = 0700
+	if err := os.MkdirAll(filepath.Dir(file), dirPerm); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	f.Close()
+	return os.Rename(f.Name(), file)
+}
+
+func sortWallets(wallets []accounts.Wallet) {
+	sort.Slice(wallets, func(i, j int) bool {
+		return wallets[i].URL().Cmp(wallets[j].URL()) < 0
+	})
+}
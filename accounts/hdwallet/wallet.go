@@ -0,0 +1,405 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package hdwallet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"math/big"
+	"sync"
+)
+
+// maxSelfDerive is the maximum number of accounts discovered by a self derivation.
+const maxSelfDerive = 128
+
+// wallet implements accounts.Wallet for an HD wallet of a seed file.
+type wallet struct {
+	hub    *Hub
+	url    accounts.URL
+	file   string
+	id     string
+	crypto keystore.CryptoJSON
+
+	accounts []accounts.Account                         // Pinned accounts in the order of pinning
+	paths    map[common.Address]accounts.DerivationPath // Derivation paths of the pinned accounts
+
+	master *extendedKey // Master key decrypted while the wallet is open
+
+	deriveBase  accounts.DerivationPath // Base path of the self derivation
+	deriveChain klaytn.ChainStateReader // Chain state reader of the self derivation
+
+	mu sync.RWMutex
+}
+
+func newWallet(hub *Hub, file, id string, cryptoStruct keystore.CryptoJSON) *wallet {
+	return &wallet{
+		hub:    hub,
+		url:    accounts.URL{Scheme: Scheme, Path: file},
+		file:   file,
+		id:     id,
+		crypto: cryptoStruct,
+		paths:  make(map[common.Address]accounts.DerivationPath),
+	}
+}
+
+// URL implements accounts.Wallet, returning the URL of the seed file.
+func (w *wallet) URL() accounts.URL {
+	return w.url
+}
+
+// Status implements accounts.Wallet, returning whether the seed is decrypted or not.
+func (w *wallet) Status() (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.master != nil {
+		return "Open", nil
+	}
+	return "Closed", nil
+}
+
+// Open implements accounts.Wallet, decrypting the seed with the passphrase.
+// The accounts of the wallet can sign without a passphrase until the wallet is closed.
+func (w *wallet) Open(passphrase string) error {
+	w.mu.Lock()
+	if w.master != nil {
+		w.mu.Unlock()
+		return accounts.ErrWalletAlreadyOpen
+	}
+	master, err := w.decryptMasterKey(passphrase)
+	if err != nil {
+		w.mu.Unlock()
+		return err
+	}
+	w.master = master
+	w.selfDerive()
+	w.mu.Unlock()
+
+	w.hub.updateFeed.Send(accounts.WalletEvent{Wallet: w, Kind: accounts.WalletOpened})
+	return nil
+}
+
+// Close implements accounts.Wallet, clearing the decrypted seed.
+func (w *wallet) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.master != nil {
+		w.master.zero()
+		w.master = nil
+	}
+	return nil
+}
+
+// Accounts implements accounts.Wallet, returning the pinned accounts of the wallet.
+func (w *wallet) Accounts() []accounts.Account {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	cpy := make([]accounts.Account, len(w.accounts))
+	copy(cpy, w.accounts)
+	return cpy
+}
+
+// Contains implements accounts.Wallet, returning whether a particular account is
+// pinned in this wallet or not.
+func (w *wallet) Contains(account accounts.Account) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	path, ok := w.paths[account.Address]
+	return ok && (account.URL == (accounts.URL{}) || account.URL == w.accountURL(path))
+}
+
+// Derive implements accounts.Wallet, deriving the account of the given path from the seed.
+// The wallet should be open to derive an account.
+func (w *wallet) Derive(path accounts.DerivationPath, pin bool) (accounts.Account, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.master == nil {
+		return accounts.Account{}, accounts.ErrWalletClosed
+	}
+	address, err := deriveAddress(w.master, path)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	account := accounts.Account{Address: address, URL: w.accountURL(path)}
+	if pin {
+		if _, ok := w.paths[address]; !ok {
+			w.pin(address, path)
+			if err := w.save(); err != nil {
+				return accounts.Account{}, err
+			}
+		}
+	}
+	return account, nil
+}
+
+// SelfDerive implements accounts.Wallet, pinning the accounts from the base path while
+// they have a balance or a nonce. The derivation is done when the wallet is open.
+func (w *wallet) SelfDerive(base accounts.DerivationPath, chain klaytn.ChainReader) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.deriveBase = append(accounts.DerivationPath{}, base...)
+	w.deriveChain, _ = chain.(klaytn.ChainStateReader)
+	if w.master != nil {
+		w.selfDerive()
+	}
+}
+
+// selfDerive discovers the used accounts from the base path, and pins them with the
+// first unused account. The caller should hold the lock of the wallet.
+func (w *wallet) selfDerive() {
+	if w.deriveChain == nil || len(w.deriveBase) == 0 {
+		return
+	}
+	path := append(accounts.DerivationPath{}, w.deriveBase...)
+	updated := false
+	for i := 0; i < maxSelfDerive; i++ {
+		address, err := deriveAddress(w.master, path)
+		if err != nil {
+			logger.Warn("Failed to derive HD wallet account", "path", path, "err", err)
+			break
+		}
+		balance, err := w.deriveChain.BalanceAt(context.Background(), address, nil)
+		if err != nil {
+			logger.Warn("Failed to get balance of HD wallet account", "address", address, "err", err)
+			break
+		}
+		nonce, err := w.deriveChain.NonceAt(context.Background(), address, nil)
+		if err != nil {
+			logger.Warn("Failed to get nonce of HD wallet account", "address", address, "err", err)
+			break
+		}
+		if _, ok := w.paths[address]; !ok {
+			w.pin(address, append(accounts.DerivationPath{}, path...))
+			updated = true
+		}
+		if balance.Sign() == 0 && nonce == 0 {
+			break
+		}
+		path[len(path)-1]++
+	}
+	if updated {
+		if err := w.save(); err != nil {
+			logger.Warn("Failed to save HD wallet accounts", "url", w.url, "err", err)
+		}
+	}
+}
+
+// SignHash implements accounts.Wallet, signing the hash with the key of the account.
+func (w *wallet) SignHash(account accounts.Account, hash []byte) ([]byte, error) {
+	key, err := w.openedKey(account)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return crypto.Sign(hash, key)
+}
+
+// SignTx implements accounts.Wallet, signing the transaction with the key of the account.
+func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := w.openedKey(account)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return signTx(key, tx, chainID)
+}
+
+// SignHashWithPassphrase implements accounts.Wallet, signing the hash with the key of
+// the account derived from the seed decrypted with the passphrase.
+func (w *wallet) SignHashWithPassphrase(account accounts.Account, passphrase string, hash []byte) ([]byte, error) {
+	key, err := w.decryptedKey(account, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return crypto.Sign(hash, key)
+}
+
+// SignTxWithPassphrase implements accounts.Wallet, signing the transaction with the key
+// of the account derived from the seed decrypted with the passphrase.
+func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := w.decryptedKey(account, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return signTx(key, tx, chainID)
+}
+
+// SignTxAsFeePayer implements accounts.Wallet, signing the transaction as a fee payer
+// with the key of the account.
+func (w *wallet) SignTxAsFeePayer(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := w.openedKey(account)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return signTxAsFeePayer(key, tx, chainID)
+}
+
+// SignTxAsFeePayerWithPassphrase implements accounts.Wallet, signing the transaction as
+// a fee payer with the key of the account derived from the seed decrypted with the passphrase.
+func (w *wallet) SignTxAsFeePayerWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := w.decryptedKey(account, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key)
+	return signTxAsFeePayer(key, tx, chainID)
+}
+
+// openedKey derives the private key of the account from the seed of the open wallet.
+func (w *wallet) openedKey(account accounts.Account) (*ecdsa.PrivateKey, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	path := w.path(account.Address)
+	if path == nil {
+		return nil, accounts.ErrUnknownAccount
+	}
+	if w.master == nil {
+		return nil, accounts.ErrWalletClosed
+	}
+	return derivePrivateKey(w.master, path)
+}
+
+// decryptedKey derives the private key of the account from the seed decrypted with the passphrase.
+func (w *wallet) decryptedKey(account accounts.Account, passphrase string) (*ecdsa.PrivateKey, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	path := w.path(account.Address)
+	if path == nil {
+		return nil, accounts.ErrUnknownAccount
+	}
+	master, err := w.decryptMasterKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer master.zero()
+	return derivePrivateKey(master, path)
+}
+
+// decryptMasterKey decrypts the seed and returns its master key.
+func (w *wallet) decryptMasterKey(passphrase string) (*extendedKey, error) {
+	seed, err := keystore.DecryptDataV3(w.crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for i := range seed {
+			seed[i] = 0
+		}
+	}()
+	return newMasterKey(seed)
+}
+
+// path returns the derivation path of the pinned account, or nil if it is not pinned.
+func (w *wallet) path(address common.Address) accounts.DerivationPath {
+	return w.paths[address]
+}
+
+// pin adds the account of the given address and path to the wallet.
+func (w *wallet) pin(address common.Address, path accounts.DerivationPath) accounts.Account {
+	account := accounts.Account{Address: address, URL: w.accountURL(path)}
+	w.accounts = append(w.accounts, account)
+	w.paths[address] = path
+	return account
+}
+
+// accountURL returns the URL of the account of the given path in the wallet.
+func (w *wallet) accountURL(path accounts.DerivationPath) accounts.URL {
+	return accounts.URL{Scheme: Scheme, Path: fmt.Sprintf("%s/%s", w.file, path)}
+}
+
+// save writes the seed file with the pinned accounts.
+func (w *wallet) save() error {
+	seedFile := seedFileJSON{
+		Crypto:  w.crypto,
+		Id:      w.id,
+		Version: seedFileVersion,
+	}
+	for _, account := range w.accounts {
+		seedFile.Accounts = append(seedFile.Accounts, seedAccountJSON{
+			Address: account.Address.Hex(),
+			Path:    w.paths[account.Address].String(),
+		})
+	}
+	content, err := json.Marshal(seedFile)
+	if err != nil {
+		return err
+	}
+	return writeSeedFile(w.file, content)
+}
+
+// derivePrivateKey returns the private key of the given path from the master key.
+func derivePrivateKey(master *extendedKey, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, err := master.derive(path)
+	if err != nil {
+		return nil, err
+	}
+	defer key.zero()
+	return key.privateKey()
+}
+
+// deriveAddress returns the address of the given path from the master key.
+func deriveAddress(master *extendedKey, path accounts.DerivationPath) (common.Address, error) {
+	key, err := derivePrivateKey(master, path)
+	if err != nil {
+		return common.Address{}, err
+	}
+	defer zeroKey(key)
+	return crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+func signTx(key *ecdsa.PrivateKey, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, keystore.ErrChainIdNil
+	}
+	return types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+}
+
+func signTxAsFeePayer(key *ecdsa.PrivateKey, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, keystore.ErrChainIdNil
+	}
+	if err := tx.SignFeePayer(types.NewEIP155Signer(chainID), key); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// zeroKey zeroes a private key in memory.
+func zeroKey(k *ecdsa.PrivateKey) {
+	b := k.D.Bits()
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/pborman/uuid"
@@ -40,6 +41,9 @@ import (
 
 const (
 	version = 3
+
+	// versionMultiKey is the version of the key file holding multiple keys grouped by the role.
+	versionMultiKey = 4
 )
 
 type Key struct {
@@ -49,6 +53,9 @@ type Key struct {
 	// we only store privkey as pubkey/address can be derived from it
 	// privkey in this struct is always in plaintext
 	PrivateKey *ecdsa.PrivateKey
+	// PrivateKeys holds the keys of a multi-key or role-based account indexed by accountkey.RoleType.
+	// It is nil for a single key account. If it is set, PrivateKey is the first key of RoleTransaction.
+	PrivateKeys [][]*ecdsa.PrivateKey
 }
 
 type keyStore interface {
@@ -61,27 +68,35 @@ type keyStore interface {
 }
 
 type plainKeyJSON struct {
-	Address    string `json:"address"`
-	PrivateKey string `json:"privatekey"`
-	Id         string `json:"id"`
-	Version    int    `json:"version"`
+	Address     string     `json:"address"`
+	PrivateKey  string     `json:"privatekey"`
+	PrivateKeys [][]string `json:"privatekeys,omitempty"`
+	Id          string     `json:"id"`
+	Version     int        `json:"version"`
 }
 
 type encryptedKeyJSONV3 struct {
 	Address string     `json:"address"`
-	Crypto  cryptoJSON `json:"crypto"`
+	Crypto  CryptoJSON `json:"crypto"`
 	Id      string     `json:"id"`
 	Version int        `json:"version"`
 }
 
+type encryptedKeyJSONV4 struct {
+	Address string         `json:"address"`
+	Keyring [][]CryptoJSON `json:"keyring"`
+	Id      string         `json:"id"`
+	Version int            `json:"version"`
+}
+
 type encryptedKeyJSONV1 struct {
 	Address string     `json:"address"`
-	Crypto  cryptoJSON `json:"crypto"`
+	Crypto  CryptoJSON `json:"crypto"`
 	Id      string     `json:"id"`
 	Version string     `json:"version"`
 }
 
-type cryptoJSON struct {
+type CryptoJSON struct {
 	Cipher       string                 `json:"cipher"`
 	CipherText   string                 `json:"ciphertext"`
 	CipherParams cipherparamsJSON       `json:"cipherparams"`
@@ -96,10 +111,20 @@ type cipherparamsJSON struct {
 
 func (k *Key) MarshalJSON() (j []byte, err error) {
 	jStruct := plainKeyJSON{
-		hex.EncodeToString(k.Address[:]),
-		hex.EncodeToString(crypto.FromECDSA(k.PrivateKey)),
-		k.Id.String(),
-		version,
+		Address:    hex.EncodeToString(k.Address[:]),
+		PrivateKey: hex.EncodeToString(crypto.FromECDSA(k.PrivateKey)),
+		Id:         k.Id.String(),
+		Version:    version,
+	}
+	if k.IsMultiKey() {
+		jStruct.Version = versionMultiKey
+		jStruct.PrivateKeys = make([][]string, len(k.PrivateKeys))
+		for role, keys := range k.PrivateKeys {
+			jStruct.PrivateKeys[role] = make([]string, len(keys))
+			for i, key := range keys {
+				jStruct.PrivateKeys[role][i] = hex.EncodeToString(crypto.FromECDSA(key))
+			}
+		}
 	}
 	j, err = json.Marshal(jStruct)
 	return j, err
@@ -127,9 +152,74 @@ func (k *Key) UnmarshalJSON(j []byte) (err error) {
 	k.Address = common.BytesToAddress(addr)
 	k.PrivateKey = privkey
 
+	if len(keyJSON.PrivateKeys) > 0 {
+		k.PrivateKeys = make([][]*ecdsa.PrivateKey, len(keyJSON.PrivateKeys))
+		for role, keys := range keyJSON.PrivateKeys {
+			k.PrivateKeys[role] = make([]*ecdsa.PrivateKey, len(keys))
+			for i, key := range keys {
+				if k.PrivateKeys[role][i], err = crypto.HexToECDSA(key); err != nil {
+					return err
+				}
+			}
+		}
+		if err := validateRoleKeys(k.PrivateKeys); err != nil {
+			return err
+		}
+		k.PrivateKey = k.PrivateKeys[accountkey.RoleTransaction][0]
+	}
+
 	return nil
 }
 
+// IsMultiKey returns true if the key holds multiple keys grouped by the role.
+func (k *Key) IsMultiKey() bool {
+	return len(k.PrivateKeys) > 0
+}
+
+// RoleKeys returns the private keys of the given role. If the key does not have
+// a key of the role, the keys of RoleTransaction are returned like AccountKeyRoleBased.
+func (k *Key) RoleKeys(role accountkey.RoleType) []*ecdsa.PrivateKey {
+	if !k.IsMultiKey() {
+		return []*ecdsa.PrivateKey{k.PrivateKey}
+	}
+	if int(role) < len(k.PrivateKeys) && len(k.PrivateKeys[role]) > 0 {
+		return k.PrivateKeys[role]
+	}
+	return k.PrivateKeys[accountkey.RoleTransaction]
+}
+
+// validateRoleKeys checks if the given role keys can be stored in a multi-key file.
+func validateRoleKeys(roleKeys [][]*ecdsa.PrivateKey) error {
+	if len(roleKeys) == 0 || len(roleKeys[accountkey.RoleTransaction]) == 0 {
+		return ErrNoTxRoleKey
+	}
+	if len(roleKeys) > int(accountkey.RoleLast) {
+		return ErrTooManyRoles
+	}
+	for _, keys := range roleKeys {
+		for _, key := range keys {
+			if key == nil {
+				return ErrNilRoleKey
+			}
+		}
+	}
+	return nil
+}
+
+func newKeyFromECDSAKeysWithAddress(roleKeys [][]*ecdsa.PrivateKey, address common.Address) (*Key, error) {
+	if err := validateRoleKeys(roleKeys); err != nil {
+		return nil, err
+	}
+	id := uuid.NewRandom()
+	key := &Key{
+		Id:          id,
+		Address:     address,
+		PrivateKey:  roleKeys[accountkey.RoleTransaction][0],
+		PrivateKeys: roleKeys,
+	}
+	return key, nil
+}
+
 func newKeyFromECDSAWithAddress(privateKeyECDSA *ecdsa.PrivateKey, address common.Address) *Key {
 	id := uuid.NewRandom()
 	key := &Key{
@@ -27,9 +27,11 @@ import (
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/ser/rlp"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -44,6 +46,10 @@ var (
 	ErrNoMatch    = errors.New("no key for given address or file")
 	ErrDecrypt    = errors.New("could not decrypt key with given passphrase")
 	ErrChainIdNil = errors.New("Chain ID should not be nil")
+
+	ErrNoTxRoleKey  = errors.New("a multi-key should have at least one key of RoleTransaction")
+	ErrTooManyRoles = errors.New("the number of roles exceeds the defined account key roles")
+	ErrNilRoleKey   = errors.New("role key should not be nil")
 )
 
 // KeyStoreType is the reflect type of a keystore backend.
@@ -236,7 +242,7 @@ func (ks *KeyStore) Delete(a accounts.Account, passphrase string) error {
 	// immediately afterwards.
 	a, key, err := ks.getDecryptedKey(a, passphrase)
 	if key != nil {
-		zeroKey(key.PrivateKey)
+		zeroKeys(key)
 	}
 	if err != nil {
 		return err
@@ -277,11 +283,20 @@ func (ks *KeyStore) SignTx(a accounts.Account, tx *types.Transaction, chainID *b
 	if !found {
 		return nil, ErrLocked
 	}
-	// Depending on the presence of the chain ID, sign with EIP155 or homestead
-	if chainID != nil {
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), unlockedKey.PrivateKey)
+	return signTx(unlockedKey.Key, tx, chainID)
+}
+
+// SignTxAsFeePayer signs the given transaction with the requested account as a fee payer.
+func (ks *KeyStore) SignTxAsFeePayer(a accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	// Look up the key to sign with and abort if it cannot be found
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	unlockedKey, found := ks.unlocked[a.Address]
+	if !found {
+		return nil, ErrLocked
 	}
-	return nil, ErrChainIdNil
+	return signTxAsFeePayer(unlockedKey.Key, tx, chainID)
 }
 
 // SignHashWithPassphrase signs hash if the private key matching the given address
@@ -292,7 +307,7 @@ func (ks *KeyStore) SignHashWithPassphrase(a accounts.Account, passphrase string
 	if err != nil {
 		return nil, err
 	}
-	defer zeroKey(key.PrivateKey)
+	defer zeroKeys(key)
 	return crypto.Sign(hash, key.PrivateKey)
 }
 
@@ -303,13 +318,66 @@ func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string,
 	if err != nil {
 		return nil, err
 	}
-	defer zeroKey(key.PrivateKey)
+	defer zeroKeys(key)
 
-	// Depending on the presence of the chain ID, sign with EIP155 or homestead
-	if chainID != nil {
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), key.PrivateKey)
+	return signTx(key, tx, chainID)
+}
+
+// SignTxAsFeePayerWithPassphrase signs the transaction as a fee payer if the private key
+// matching the given address can be decrypted with the given passphrase.
+func (ks *KeyStore) SignTxAsFeePayerWithPassphrase(a accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
 	}
-	return nil, ErrChainIdNil
+	defer zeroKeys(key)
+
+	return signTxAsFeePayer(key, tx, chainID)
+}
+
+// signTx signs a copy of the transaction with the given key. A multi-key signs it
+// with all keys of the role which is used to validate the transaction.
+func signTx(key *Key, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, ErrChainIdNil
+	}
+	signer := types.NewEIP155Signer(chainID)
+	if !key.IsMultiKey() || tx.IsLegacyTransaction() {
+		return types.SignTx(tx, signer, key.PrivateKey)
+	}
+	cpy, err := copyTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cpy.SignWithKeys(signer, key.RoleKeys(tx.GetRoleTypeForValidation())); err != nil {
+		return nil, err
+	}
+	return cpy, nil
+}
+
+// signTxAsFeePayer signs a copy of the fee-delegated transaction with the keys of RoleFeePayer.
+func signTxAsFeePayer(key *Key, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, ErrChainIdNil
+	}
+	cpy, err := copyTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cpy.SignFeePayerWithKeys(types.NewEIP155Signer(chainID), key.RoleKeys(accountkey.RoleFeePayer)); err != nil {
+		return nil, err
+	}
+	return cpy, nil
+}
+
+// copyTx returns a deep copy of the transaction, so that signing the copy leaves the
+// transaction of the caller unchanged.
+func copyTx(tx *types.Transaction) (*types.Transaction, error) {
+	enc, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	return types.DecodeUnsignedTx(enc)
 }
 
 // Unlock unlocks the given account indefinitely.
@@ -357,7 +425,7 @@ func (ks *KeyStore) TimedUnlock(a accounts.Account, passphrase string, timeout t
 		if u.abort == nil {
 			// The address was unlocked indefinitely, so unlocking
 			// it with a timeout would be confusing.
-			zeroKey(key.PrivateKey)
+			zeroKeys(key)
 			return nil
 		}
 		// Terminate the expire goroutine and replace it below.
@@ -404,7 +472,7 @@ func (ks *KeyStore) expire(addr common.Address, u *unlocked, timeout time.Durati
 		// because the map stores a new pointer every time the key is
 		// unlocked.
 		if ks.unlocked[addr] == u {
-			zeroKey(u.PrivateKey)
+			zeroKeys(u.Key)
 			delete(ks.unlocked, addr)
 		}
 		ks.mu.Unlock()
@@ -444,7 +512,7 @@ func (ks *KeyStore) Export(a accounts.Account, passphrase, newPassphrase string)
 func (ks *KeyStore) Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error) {
 	key, err := DecryptKey(keyJSON, passphrase)
 	if key != nil && key.PrivateKey != nil {
-		defer zeroKey(key.PrivateKey)
+		defer zeroKeys(key)
 	}
 	if err != nil {
 		return accounts.Account{}, err
@@ -493,6 +561,45 @@ func (ks *KeyStore) ImportECDSAWithAddress(priv *ecdsa.PrivateKey, passphrase st
 	return ks.importKey(key, passphrase)
 }
 
+// ImportECDSAKeysWithAddress stores the given keys grouped by accountkey.RoleType and the address
+// into the key directory, encrypting it with the passphrase.
+func (ks *KeyStore) ImportECDSAKeysWithAddress(roleKeys [][]*ecdsa.PrivateKey, passphrase string, address common.Address) (accounts.Account, error) {
+	key, err := newKeyFromECDSAKeysWithAddress(roleKeys, address)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	if ks.cache.hasAddress(key.Address) {
+		return accounts.Account{}, fmt.Errorf("account already exists")
+	}
+	return ks.importKey(key, passphrase)
+}
+
+// ReplaceECDSAKeysWithAddress replaces the key of the given address with the given keys grouped by
+// accountkey.RoleType, encrypting it with the newPassphrase.
+// This first checks that the target address exists and it can be unlocked with passphrase.
+func (ks *KeyStore) ReplaceECDSAKeysWithAddress(roleKeys [][]*ecdsa.PrivateKey, passphrase string, newPassphrase string, address common.Address) (accounts.Account, error) {
+	key, err := newKeyFromECDSAKeysWithAddress(roleKeys, address)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	// Before replacing, lock the account first.
+	if err := ks.Lock(key.Address); err != nil {
+		return accounts.Account{}, err
+	}
+
+	acc, err := ks.cache.find(accounts.Account{Address: key.Address})
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	if err := ks.UpdateKey(acc, key, passphrase, newPassphrase); err != nil {
+		return accounts.Account{}, err
+	}
+	ks.refreshWallets()
+
+	return acc, nil
+}
+
 // ImportECDSA stores the given key into the key directory, encrypting it with the passphrase.
 func (ks *KeyStore) ImportECDSA(priv *ecdsa.PrivateKey, passphrase string) (accounts.Account, error) {
 	key := newKeyFromECDSA(priv)
@@ -530,6 +637,16 @@ func (ks *KeyStore) Update(a accounts.Account, passphrase, newPassphrase string)
 	return ks.storage.StoreKey(a.URL.Path, key, newPassphrase)
 }
 
+// zeroKeys zeroes all private keys of the given key in memory.
+func zeroKeys(k *Key) {
+	zeroKey(k.PrivateKey)
+	for _, keys := range k.PrivateKeys {
+		for _, key := range keys {
+			zeroKey(key)
+		}
+	}
+}
+
 // zeroKey zeroes a private key in memory.
 func zeroKey(k *ecdsa.PrivateKey) {
 	b := k.D.Bits()
@@ -23,11 +23,13 @@ package keystore
 import (
 	"bytes"
 	"crypto/aes"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
@@ -108,29 +110,67 @@ func (ks keyStorePassphrase) JoinPath(filename string) string {
 
 // EncryptKey encrypts a key using the specified scrypt parameters into a json
 // blob that can be decrypted later on.
+// A multi-key is encrypted into the version 4 json which has a keyring instead of crypto.
+// All keys of the keyring are encrypted with a key derived once with the same salt.
 func EncryptKey(key *Key, auth string, scryptN, scryptP int) ([]byte, error) {
-	authArray := []byte(auth)
-
-	salt := make([]byte, 32)
-	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
-		panic("reading from crypto/rand failed: " + err.Error())
+	if key.IsMultiKey() {
+		derivedKey, kdfParams, err := deriveKeyV3(auth, scryptN, scryptP)
+		if err != nil {
+			return nil, err
+		}
+		keyring := make([][]CryptoJSON, len(key.PrivateKeys))
+		for role, keys := range key.PrivateKeys {
+			keyring[role] = make([]CryptoJSON, len(keys))
+			for i, k := range keys {
+				cryptoStruct, err := encryptDataWithKey(math.PaddedBigBytes(k.D, 32), derivedKey, kdfParams)
+				if err != nil {
+					return nil, err
+				}
+				keyring[role][i] = cryptoStruct
+			}
+		}
+		encryptedKeyJSONV4 := encryptedKeyJSONV4{
+			hex.EncodeToString(key.Address[:]),
+			keyring,
+			key.Id.String(),
+			versionMultiKey,
+		}
+		return json.Marshal(encryptedKeyJSONV4)
 	}
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptR, scryptP, scryptDKLen)
+
+	cryptoStruct, err := EncryptDataV3(math.PaddedBigBytes(key.PrivateKey.D, 32), auth, scryptN, scryptP)
 	if err != nil {
 		return nil, err
 	}
-	encryptKey := derivedKey[:16]
-	keyBytes := math.PaddedBigBytes(key.PrivateKey.D, 32)
+	encryptedKeyJSONV3 := encryptedKeyJSONV3{
+		hex.EncodeToString(key.Address[:]),
+		cryptoStruct,
+		key.Id.String(),
+		version,
+	}
+	return json.Marshal(encryptedKeyJSONV3)
+}
 
-	iv := make([]byte, aes.BlockSize) // 16
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+// EncryptDataV3 encrypts the given data with a new salt and IV in the format
+// of the crypto section of version 3 key files.
+func EncryptDataV3(data []byte, auth string, scryptN, scryptP int) (CryptoJSON, error) {
+	derivedKey, kdfParams, err := deriveKeyV3(auth, scryptN, scryptP)
+	if err != nil {
+		return CryptoJSON{}, err
+	}
+	return encryptDataWithKey(data, derivedKey, kdfParams)
+}
+
+// deriveKeyV3 derives a key from auth with a new salt, and returns it with its scrypt parameters.
+func deriveKeyV3(auth string, scryptN, scryptP int) ([]byte, map[string]interface{}, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
 		panic("reading from crypto/rand failed: " + err.Error())
 	}
-	cipherText, err := aesCTRXOR(encryptKey, keyBytes, iv)
+	derivedKey, err := scrypt.Key([]byte(auth), salt, scryptN, scryptR, scryptP, scryptDKLen)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
 
 	scryptParamsJSON := make(map[string]interface{}, 5)
 	scryptParamsJSON["n"] = scryptN
@@ -138,26 +178,35 @@ func EncryptKey(key *Key, auth string, scryptN, scryptP int) ([]byte, error) {
 	scryptParamsJSON["p"] = scryptP
 	scryptParamsJSON["dklen"] = scryptDKLen
 	scryptParamsJSON["salt"] = hex.EncodeToString(salt)
+	return derivedKey, scryptParamsJSON, nil
+}
+
+// encryptDataWithKey encrypts the given data with the derived key and a new IV.
+func encryptDataWithKey(data, derivedKey []byte, kdfParams map[string]interface{}) (CryptoJSON, error) {
+	encryptKey := derivedKey[:16]
+
+	iv := make([]byte, aes.BlockSize) // 16
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		panic("reading from crypto/rand failed: " + err.Error())
+	}
+	cipherText, err := aesCTRXOR(encryptKey, data, iv)
+	if err != nil {
+		return CryptoJSON{}, err
+	}
+	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
 
 	cipherParamsJSON := cipherparamsJSON{
 		IV: hex.EncodeToString(iv),
 	}
 
-	cryptoStruct := cryptoJSON{
+	return CryptoJSON{
 		Cipher:       "aes-128-ctr",
 		CipherText:   hex.EncodeToString(cipherText),
 		CipherParams: cipherParamsJSON,
 		KDF:          keyHeaderKDF,
-		KDFParams:    scryptParamsJSON,
+		KDFParams:    kdfParams,
 		MAC:          hex.EncodeToString(mac),
-	}
-	encryptedKeyJSONV3 := encryptedKeyJSONV3{
-		hex.EncodeToString(key.Address[:]),
-		cryptoStruct,
-		key.Id.String(),
-		version,
-	}
-	return json.Marshal(encryptedKeyJSONV3)
+	}, nil
 }
 
 // DecryptKey decrypts a key from a json blob, returning the private key itself.
@@ -173,6 +222,13 @@ func DecryptKey(keyjson []byte, auth string) (*Key, error) {
 		err             error
 		address         common.Address
 	)
+	if version, ok := m["version"].(float64); ok && version == versionMultiKey {
+		k := new(encryptedKeyJSONV4)
+		if err := json.Unmarshal(keyjson, k); err != nil {
+			return nil, err
+		}
+		return decryptKeyV4(k, auth)
+	}
 	if version, ok := m["version"].(string); ok && version == "1" {
 		k := new(encryptedKeyJSONV1)
 		if err := json.Unmarshal(keyjson, k); err != nil {
@@ -206,41 +262,86 @@ func decryptKeyV3(keyProtected *encryptedKeyJSONV3, auth string) (keyBytes []byt
 		return nil, nil, fmt.Errorf("Version not supported: %v", keyProtected.Version)
 	}
 
-	if keyProtected.Crypto.Cipher != "aes-128-ctr" {
-		return nil, nil, fmt.Errorf("Cipher not supported: %v", keyProtected.Crypto.Cipher)
-	}
-
 	keyId = uuid.Parse(keyProtected.Id)
-	mac, err := hex.DecodeString(keyProtected.Crypto.MAC)
+	plainText, err := DecryptDataV3(keyProtected.Crypto, auth)
 	if err != nil {
 		return nil, nil, err
 	}
+	return plainText, keyId, err
+}
 
-	iv, err := hex.DecodeString(keyProtected.Crypto.CipherParams.IV)
+// decryptKeyV4 decrypts all keys in the keyring of a multi-key json. A key is derived
+// once for the keys which share the same key derivation parameters.
+func decryptKeyV4(keyProtected *encryptedKeyJSONV4, auth string) (*Key, error) {
+	derivedKeys := make(map[string][]byte)
+	roleKeys := make([][]*ecdsa.PrivateKey, len(keyProtected.Keyring))
+	for role, keys := range keyProtected.Keyring {
+		roleKeys[role] = make([]*ecdsa.PrivateKey, len(keys))
+		for i, cryptoStruct := range keys {
+			kdf := fmt.Sprint(cryptoStruct.KDF, cryptoStruct.KDFParams)
+			derivedKey, ok := derivedKeys[kdf]
+			if !ok {
+				var err error
+				if derivedKey, err = getKDFKey(cryptoStruct, auth); err != nil {
+					return nil, err
+				}
+				derivedKeys[kdf] = derivedKey
+			}
+			keyBytes, err := decryptDataWithKey(cryptoStruct, derivedKey)
+			if err != nil {
+				return nil, err
+			}
+			roleKeys[role][i] = crypto.ToECDSAUnsafe(keyBytes)
+		}
+	}
+	if err := validateRoleKeys(roleKeys); err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Id:          uuid.Parse(keyProtected.Id),
+		Address:     common.HexToAddress(keyProtected.Address),
+		PrivateKey:  roleKeys[accountkey.RoleTransaction][0],
+		PrivateKeys: roleKeys,
+	}, nil
+}
+
+// DecryptDataV3 decrypts the data encrypted by EncryptDataV3.
+func DecryptDataV3(cryptoStruct CryptoJSON, auth string) ([]byte, error) {
+	derivedKey, err := getKDFKey(cryptoStruct, auth)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	return decryptDataWithKey(cryptoStruct, derivedKey)
+}
 
-	cipherText, err := hex.DecodeString(keyProtected.Crypto.CipherText)
+// decryptDataWithKey decrypts the data encrypted by encryptDataWithKey.
+func decryptDataWithKey(cryptoStruct CryptoJSON, derivedKey []byte) ([]byte, error) {
+	if cryptoStruct.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("Cipher not supported: %v", cryptoStruct.Cipher)
+	}
+
+	mac, err := hex.DecodeString(cryptoStruct.MAC)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	derivedKey, err := getKDFKey(keyProtected.Crypto, auth)
+	iv, err := hex.DecodeString(cryptoStruct.CipherParams.IV)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	cipherText, err := hex.DecodeString(cryptoStruct.CipherText)
+	if err != nil {
+		return nil, err
 	}
 
 	calculatedMAC := crypto.Keccak256(derivedKey[16:32], cipherText)
 	if !bytes.Equal(calculatedMAC, mac) {
-		return nil, nil, ErrDecrypt
+		return nil, ErrDecrypt
 	}
 
-	plainText, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
-	if err != nil {
-		return nil, nil, err
-	}
-	return plainText, keyId, err
+	return aesCTRXOR(derivedKey[:16], cipherText, iv)
 }
 
 func decryptKeyV1(keyProtected *encryptedKeyJSONV1, auth string) (keyBytes []byte, keyId []byte, err error) {
@@ -277,7 +378,7 @@ func decryptKeyV1(keyProtected *encryptedKeyJSONV1, auth string) (keyBytes []byt
 	return plainText, keyId, err
 }
 
-func getKDFKey(cryptoJSON cryptoJSON, auth string) ([]byte, error) {
+func getKDFKey(cryptoJSON CryptoJSON, auth string) ([]byte, error) {
 	authArray := []byte(auth)
 	salt, err := hex.DecodeString(cryptoJSON.KDFParams["salt"].(string))
 	if err != nil {
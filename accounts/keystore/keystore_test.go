@@ -21,7 +21,11 @@
 package keystore
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"math/rand"
 	"os"
 	"runtime"
@@ -31,8 +35,12 @@ import (
 	"time"
 
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/ser/rlp"
 )
 
 var testSigData = make([]byte, 32)
@@ -362,6 +370,169 @@ func checkAccounts(t *testing.T, live map[common.Address]accounts.Account, walle
 	}
 }
 
+// TestMultiKeySignTx checks if a multi-key account signs transactions with the keys of the proper role.
+func TestMultiKeySignTx(t *testing.T) {
+	dir, ks := tmpKeyStore(t, true)
+	defer os.RemoveAll(dir)
+
+	roleKeys := make([][]*ecdsa.PrivateKey, accountkey.RoleLast)
+	for role, n := range []int{2, 1, 1} {
+		for i := 0; i < n; i++ {
+			key, err := crypto.GenerateKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			roleKeys[role] = append(roleKeys[role], key)
+		}
+	}
+	addr := common.HexToAddress("0x1234")
+	a, err := ks.ImportECDSAKeysWithAddress(roleKeys, "foo", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Address != addr {
+		t.Fatalf("address mismatch: have %x, want %x", a.Address, addr)
+	}
+	if _, err := ks.ImportECDSAKeysWithAddress(roleKeys, "foo", addr); err == nil {
+		t.Fatal("importing the same address should fail")
+	}
+	if _, err := ks.ImportECDSAKeysWithAddress([][]*ecdsa.PrivateKey{nil, roleKeys[1]}, "foo", common.HexToAddress("0x5678")); err != ErrNoTxRoleKey {
+		t.Fatalf("wrong error: have %v, want %v", err, ErrNoTxRoleKey)
+	}
+
+	chainID := big.NewInt(1)
+	signer := types.NewEIP155Signer(chainID)
+	checkSigners := func(pubs []*ecdsa.PublicKey, keys []*ecdsa.PrivateKey) {
+		if len(pubs) != len(keys) {
+			t.Fatalf("signature count mismatch: have %d, want %d", len(pubs), len(keys))
+		}
+		for i := range pubs {
+			if crypto.PubkeyToAddress(*pubs[i]) != crypto.PubkeyToAddress(keys[i].PublicKey) {
+				t.Errorf("signature %d is not signed by the role key", i)
+			}
+		}
+	}
+
+	// A value transfer is signed by all keys of RoleTransaction.
+	tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyFrom:     addr,
+		types.TxValueKeyTo:       common.HexToAddress("0x1"),
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := ks.SignTxWithPassphrase(a, "foo", tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc, _ := rlp.EncodeToBytes(tx); !bytes.Equal(enc, unsigned) {
+		t.Fatal("the transaction of the caller should not be signed")
+	}
+	pubs, err := signer.SenderPubkey(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSigners(pubs, roleKeys[accountkey.RoleTransaction])
+
+	// An account update is signed by the key of RoleAccountUpdate.
+	tx, err = types.NewTransactionWithMap(types.TxTypeAccountUpdate, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:      uint64(1),
+		types.TxValueKeyFrom:       addr,
+		types.TxValueKeyGasLimit:   uint64(100000),
+		types.TxValueKeyGasPrice:   big.NewInt(25),
+		types.TxValueKeyAccountKey: accountkey.NewAccountKeyLegacy(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Unlock(a, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if signed, err = ks.SignTx(a, tx, chainID); err != nil {
+		t.Fatal(err)
+	}
+	pubs, err = signer.SenderPubkey(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSigners(pubs, roleKeys[accountkey.RoleAccountUpdate])
+
+	// A fee-delegated transaction is signed by the key of RoleFeePayer as a fee payer.
+	tx, err = types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyFrom:     common.HexToAddress("0x1"),
+		types.TxValueKeyTo:       common.HexToAddress("0x2"),
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyFeePayer: addr,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	senderKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Sign(signer, senderKey); err != nil {
+		t.Fatal(err)
+	}
+	if unsigned, err = rlp.EncodeToBytes(tx); err != nil {
+		t.Fatal(err)
+	}
+	if signed, err = ks.SignTxAsFeePayer(a, tx, chainID); err != nil {
+		t.Fatal(err)
+	}
+	if enc, _ := rlp.EncodeToBytes(tx); !bytes.Equal(enc, unsigned) {
+		t.Fatal("the transaction of the caller should not be signed by the fee payer")
+	}
+	pubs, err = signer.SenderFeePayer(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSigners(pubs, roleKeys[accountkey.RoleFeePayer])
+
+	// The keys survive the export and import.
+	keyJSON, err := ks.Export(a, "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported encryptedKeyJSONV4
+	if err := json.Unmarshal(keyJSON, &exported); err != nil {
+		t.Fatal(err)
+	}
+	salt := exported.Keyring[0][0].KDFParams["salt"]
+	for _, keys := range exported.Keyring {
+		for _, k := range keys {
+			if k.KDFParams["salt"] != salt {
+				t.Fatal("the keys of a keyring should be encrypted with a key derived once")
+			}
+		}
+	}
+	key, err := DecryptKey(keyJSON, "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Address != addr || len(key.PrivateKeys) != len(roleKeys) {
+		t.Fatalf("decrypted key mismatch: address %x, roles %d", key.Address, len(key.PrivateKeys))
+	}
+	for role := range roleKeys {
+		for i := range roleKeys[role] {
+			if key.PrivateKeys[role][i].D.Cmp(roleKeys[role][i].D) != 0 {
+				t.Errorf("key %d of role %d mismatch", i, role)
+			}
+		}
+	}
+}
+
 // checkEvents checks that all events in 'want' are present in 'have'. Events may be present multiple times.
 func checkEvents(t *testing.T, want []walletEvent, have []walletEvent) {
 	for _, wantEv := range want {
@@ -22,6 +22,7 @@ package api
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
@@ -32,6 +33,7 @@ import (
 	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/ser/rlp"
+	"strings"
 	"time"
 )
 
@@ -184,6 +186,45 @@ func (s *PrivateAccountAPI) ImportRawKey(privkey string, password string) (commo
 	return acc.Address, err
 }
 
+// parseRoleKeys converts the given hex encoded ECDSA keys grouped by the role into private keys.
+func parseRoleKeys(roleKeys [][]string) ([][]*ecdsa.PrivateKey, error) {
+	keys := make([][]*ecdsa.PrivateKey, len(roleKeys))
+	for role, rawKeys := range roleKeys {
+		keys[role] = make([]*ecdsa.PrivateKey, len(rawKeys))
+		for i, rawKey := range rawKeys {
+			key, err := crypto.HexToECDSA(strings.TrimPrefix(rawKey, "0x"))
+			if err != nil {
+				return nil, err
+			}
+			keys[role][i] = key
+		}
+	}
+	return keys, nil
+}
+
+// ImportRawKeys stores the given hex encoded ECDSA keys of a multi-key or role-based account
+// into the key directory, encrypting them with the passphrase.
+// The keys are grouped by the role in the order of RoleTransaction, RoleAccountUpdate and RoleFeePayer.
+func (s *PrivateAccountAPI) ImportRawKeys(roleKeys [][]string, address common.Address, password string) (common.Address, error) {
+	keys, err := parseRoleKeys(roleKeys)
+	if err != nil {
+		return common.Address{}, err
+	}
+	acc, err := fetchKeystore(s.am).ImportECDSAKeysWithAddress(keys, password, address)
+	return acc.Address, err
+}
+
+// ReplaceRawKeys replaces the key of the given address with the given hex encoded ECDSA keys
+// grouped by the role, encrypting them with the new passphrase.
+func (s *PrivateAccountAPI) ReplaceRawKeys(roleKeys [][]string, address common.Address, passphrase string, newPassphrase string) (common.Address, error) {
+	keys, err := parseRoleKeys(roleKeys)
+	if err != nil {
+		return common.Address{}, err
+	}
+	acc, err := fetchKeystore(s.am).ReplaceECDSAKeysWithAddress(keys, passphrase, newPassphrase, address)
+	return acc.Address, err
+}
+
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
 // default of 300 seconds. It returns an indication if the account was unlocked.
@@ -333,6 +374,60 @@ func (s *PrivateAccountAPI) SignTransaction(ctx context.Context, args SendTxArgs
 	return &SignTransactionResult{data, signed}, nil
 }
 
+// SignTxEnvelope signs the transaction in the envelope with the key of the sender associated with
+// the keystore, and returns the envelope with the signature added. If the given passwd isn't
+// able to decrypt the key it fails.
+func (s *PrivateAccountAPI) SignTxEnvelope(ctx context.Context, env types.TxEnvelope, passwd string) (*types.TxEnvelope, error) {
+	tx, err := env.Transaction()
+	if err != nil {
+		return nil, err
+	}
+	from, err := tx.From()
+	if err != nil {
+		return nil, err
+	}
+	account := accounts.Account{Address: from}
+	wallet, err := s.am.Find(account)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := wallet.SignTxWithPassphrase(account, passwd, tx, env.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	if err := env.AddSignatures(signed.RawSignatureValues()); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// SignTxEnvelopeAsFeePayer signs the fee-delegated transaction in the envelope with the key of the fee payer
+// associated with the keystore, and returns the envelope with the signature added. If the given passwd isn't
+// able to decrypt the key it fails.
+func (s *PrivateAccountAPI) SignTxEnvelopeAsFeePayer(ctx context.Context, env types.TxEnvelope, passwd string) (*types.TxEnvelope, error) {
+	tx, err := env.Transaction()
+	if err != nil {
+		return nil, err
+	}
+	feePayer, err := tx.FeePayer()
+	if err != nil {
+		return nil, err
+	}
+	account := accounts.Account{Address: feePayer}
+	wallet, err := s.am.Find(account)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := wallet.SignTxAsFeePayerWithPassphrase(account, passwd, tx, env.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	if err := env.AddFeePayerSignatures(signed.RawFeePayerSignatureValues()); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
 // signHash is a helper function that calculates a hash for the given message that can be
 // safely used to calculate a signature from.
 //
@@ -23,6 +23,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/stretchr/testify/require"
 	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -95,3 +96,43 @@ func TestPrivateAccountAPI_ImportRawKey(t *testing.T) {
 		require.Equal(t, common.HexToAddress("0x819104a190255e0cedbdd9d5f59a557633d79db2"), addr)
 	}
 }
+
+// TestPrivateAccountAPI_ImportRawKeys tests ImportRawKeys() and ReplaceRawKeys().
+func TestPrivateAccountAPI_ImportRawKeys(t *testing.T) {
+	keydir, err := ioutil.TempDir("", "klay-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(keydir)
+
+	ks := keystore.NewKeyStore(keydir, keystore.LightScryptN, keystore.LightScryptP)
+	api := PrivateAccountAPI{
+		am:        accounts.NewManager(ks),
+		nonceLock: new(AddrLocker),
+		b:         nil,
+	}
+	address := common.HexToAddress("0x819104a190255e0cedbdd9d5f59a557633d79db1")
+
+	// 1. Import role-based keys.
+	{
+		roleKeys := [][]string{
+			{"aebb680a5e596c1d1a01bac78a3985b62c685c5e995d780c176138cb2679ba3e", "0xf8cc7c3813ad23817466b1802ee805ee417001fcce9376ab8728c92dd8ea0a6b"},
+			{"1ea7b7bc7f525cc936ec65e0e93f146bd6fad4b3158067ad64560defd9bba0b0"},
+		}
+		addr, err := api.ImportRawKeys(roleKeys, address, "1234")
+		require.NoError(t, err)
+		require.Equal(t, address, addr)
+
+		_, err = api.ImportRawKeys(roleKeys, address, "1234")
+		require.Equal(t, fmt.Errorf("account already exists"), err)
+	}
+
+	// 2. Replace the keys. The keys of RoleTransaction are mandatory.
+	{
+		_, err := api.ReplaceRawKeys([][]string{{}, {"aebb680a5e596c1d1a01bac78a3985b62c685c5e995d780c176138cb2679ba3e"}}, address, "1234", "5678")
+		require.Equal(t, keystore.ErrNoTxRoleKey, err)
+
+		addr, err := api.ReplaceRawKeys([][]string{{"aebb680a5e596c1d1a01bac78a3985b62c685c5e995d780c176138cb2679ba3e"}}, address, "1234", "5678")
+		require.NoError(t, err)
+		require.Equal(t, address, addr)
+		require.NoError(t, ks.Unlock(accounts.Account{Address: address}, "5678"))
+	}
+}
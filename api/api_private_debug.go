@@ -21,9 +21,12 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/vm"
 	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
 	"strings"
@@ -84,3 +87,17 @@ func (api *PrivateDebugAPI) SetHead(number hexutil.Uint64) error {
 		api.b.SetHead(uint64(number))
 	*/
 }
+
+// ProfileComputationCostCall executes the given call on the state of the given block and returns
+// the number of executions, the gas and the computation cost of each opcode and precompiled contract.
+func (api *PrivateDebugAPI) ProfileComputationCostCall(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber) (*vm.ComputationCostResult, error) {
+	profiler := vm.NewComputationCostProfiler(false)
+	vmCfg := vm.Config{Debug: true, Tracer: profiler, UseOpcodeComputationCost: true}
+
+	_, gas, _, failed, err := NewPublicBlockChainAPI(api.b).doCall(ctx, args, blockNr, vmCfg, localTxExecutionTime)
+	// A call failed in the EVM still has the gas used by it, while an invalid call has none.
+	if err != nil && !(failed && gas > 0) {
+		return nil, err
+	}
+	return profiler.Result(gas, failed), nil
+}
@@ -22,6 +22,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -44,6 +45,8 @@ const localTxExecutionTime = 5 * time.Second
 
 var logger = log.NewModuleLogger(log.API)
 
+var errHumanReadableNotRegistered = errors.New("the human-readable address is not registered")
+
 // PublicBlockChainAPI provides an API to access the Klaytn blockchain.
 // It offers only methods that operate on public data that is freely available to anyone.
 type PublicBlockChainAPI struct {
@@ -81,13 +84,98 @@ func (s *PublicBlockChainAPI) IsContractAccount(ctx context.Context, address com
 
 // IsHumanReadable returns true if the account associated with addr is a human-readable account.
 // It returns false otherwise.
-//func (s *PublicBlockChainAPI) IsHumanReadable(ctx context.Context, address common.Address, blockNr rpc.BlockNumber) (bool, error) {
-//	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
-//	if err != nil {
-//		return false, err
-//	}
-//	return state.IsHumanReadable(address), state.Error()
-//}
+func (s *PublicBlockChainAPI) IsHumanReadable(ctx context.Context, address common.Address, blockNr rpc.BlockNumber) (bool, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if err != nil {
+		return false, err
+	}
+	return state.IsHumanReadable(address), state.Error()
+}
+
+// ResolveHumanReadable returns the address of the human-readable address like "colin.klaytn".
+// It returns an error if the human-readable address is not registered at the given block.
+func (s *PublicBlockChainAPI) ResolveHumanReadable(ctx context.Context, hra string, blockNr rpc.BlockNumber) (common.Address, error) {
+	address, err := common.HumanReadableToAddress(hra)
+	if err != nil {
+		return common.Address{}, err
+	}
+	registered, err := s.IsHumanReadable(ctx, address, blockNr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !registered {
+		return common.Address{}, errHumanReadableNotRegistered
+	}
+	return address, nil
+}
+
+// GetHumanReadable returns the human-readable address registered at the address.
+// It returns an error if the address is not a registered human-readable address at the given block.
+func (s *PublicBlockChainAPI) GetHumanReadable(ctx context.Context, address common.Address, blockNr rpc.BlockNumber) (string, error) {
+	hra, err := common.AddressToHumanReadable(address)
+	if err != nil {
+		return "", err
+	}
+	registered, err := s.IsHumanReadable(ctx, address, blockNr)
+	if err != nil {
+		return "", err
+	}
+	if !registered {
+		return "", errHumanReadableNotRegistered
+	}
+	return hra, nil
+}
+
+// AccountKeyChange represents a change of the account key of an address.
+// OldKey is nil if the account did not exist before the change.
+type AccountKeyChange struct {
+	BlockNumber hexutil.Uint64                   `json:"blockNumber"`
+	TxHash      common.Hash                      `json:"txHash"`
+	OldKey      *accountkey.AccountKeySerializer `json:"oldKey"`
+	NewKey      *accountkey.AccountKeySerializer `json:"newKey"`
+}
+
+// GetAccountKeyHistory returns the account key changes of the address in the order of the changes.
+// It returns an error if the account key history indexing is not enabled.
+func (s *PublicBlockChainAPI) GetAccountKeyHistory(ctx context.Context, address common.Address) ([]*AccountKeyChange, error) {
+	history, err := s.b.GetAccountKeyHistory(address)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*AccountKeyChange, len(history))
+	for i, h := range history {
+		changes[i] = &AccountKeyChange{
+			BlockNumber: hexutil.Uint64(h.BlockNumber),
+			TxHash:      h.TxHash,
+			NewKey:      accountkey.NewAccountKeySerializerWithAccountKey(h.NewKey),
+		}
+		if h.OldKey != nil {
+			changes[i].OldKey = accountkey.NewAccountKeySerializerWithAccountKey(h.OldKey)
+		}
+	}
+	return changes, nil
+}
+
+// ValidateSignatures returns true if the signatures of the message hash are valid for the given role
+// of the account key of the address at the given block, in the same way as the signatures of a transaction.
+// Each signature should be 65 bytes in the [R || S || V] format.
+func (s *PublicBlockChainAPI) ValidateSignatures(ctx context.Context, address common.Address, msgHash common.Hash, sigs []hexutil.Bytes, role accountkey.RoleType, blockNr rpc.BlockNumber) (bool, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return false, err
+	}
+	accKey := state.GetKey(address)
+	if err := state.Error(); err != nil {
+		return false, err
+	}
+
+	rawSigs := make([][]byte, len(sigs))
+	for i, sig := range sigs {
+		rawSigs[i] = sig
+	}
+	return accountkey.ValidateSignatures(address, accKey, msgHash, rawSigs, role)
+}
 
 // GetBlockReceipts returns all the transaction receipts for the given block hash.
 func (s *PublicBlockChainAPI) GetBlockReceipts(ctx context.Context, blockHash common.Hash) ([]map[string]interface{}, error) {
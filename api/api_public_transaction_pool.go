@@ -588,3 +588,96 @@ func (s *PublicTransactionPoolAPI) Resend(ctx context.Context, sendArgs SendTxAr
 
 	return common.Hash{}, fmt.Errorf("Transaction %#x not found", matchTx.Hash())
 }
+
+var (
+	errTxEnvelopeChainId         = errors.New("the chain ID of the envelope does not match the chain")
+	errTxEnvelopeNotEnoughWeight = errors.New("the signatures of the envelope do not satisfy the threshold")
+)
+
+// SignatureWeight is the weighted sum of the keys which signed a transaction and the threshold
+// the sum should reach.
+type SignatureWeight struct {
+	Weight    uint `json:"weight"`
+	Threshold uint `json:"threshold"`
+	Satisfied bool `json:"satisfied"`
+}
+
+// TxEnvelopeWeights is the signature weights of the sender and the fee payer of a transaction envelope.
+// FeePayer is nil if the transaction is not a fee-delegated transaction.
+type TxEnvelopeWeights struct {
+	Sender   SignatureWeight  `json:"sender"`
+	FeePayer *SignatureWeight `json:"feePayer"`
+}
+
+// NewTxEnvelope puts the RLP-encoded transaction in an envelope to collect the signatures
+// of multiple keys. The transaction may be unsigned or partially signed.
+func (s *PublicTransactionPoolAPI) NewTxEnvelope(encodedTx hexutil.Bytes) (*types.TxEnvelope, error) {
+	tx, err := types.DecodeUnsignedTx(encodedTx)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTxEnvelope(tx, s.b.ChainConfig().ChainID)
+}
+
+// InspectTxEnvelope returns the weighted sums of the keys which signed the transaction in the envelope
+// against the thresholds of the account keys of the sender and the fee payer at the latest block.
+func (s *PublicTransactionPoolAPI) InspectTxEnvelope(ctx context.Context, env types.TxEnvelope) (*TxEnvelopeWeights, error) {
+	if env.ChainID == nil || env.ChainID.ToInt().Cmp(s.b.ChainConfig().ChainID) != 0 {
+		return nil, errTxEnvelopeChainId
+	}
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	tx, err := env.Transaction()
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := tx.From()
+	if err != nil {
+		return nil, err
+	}
+	pubkeys, err := env.SenderPubkeys()
+	if err != nil {
+		return nil, err
+	}
+	weights := &TxEnvelopeWeights{
+		Sender: newSignatureWeight(accountkey.WeightOfSignatures(from, state.GetKey(from), pubkeys, tx.GetRoleTypeForValidation())),
+	}
+
+	if tx.IsFeeDelegatedTransaction() {
+		feePayer, err := tx.FeePayer()
+		if err != nil {
+			return nil, err
+		}
+		pubkeys, err := env.FeePayerPubkeys()
+		if err != nil {
+			return nil, err
+		}
+		weight := newSignatureWeight(accountkey.WeightOfSignatures(feePayer, state.GetKey(feePayer), pubkeys, accountkey.RoleFeePayer))
+		weights.FeePayer = &weight
+	}
+	return weights, nil
+}
+
+// SendTxEnvelope submits the transaction in the envelope if the collected signatures satisfy
+// the thresholds of the sender and the fee payer.
+func (s *PublicTransactionPoolAPI) SendTxEnvelope(ctx context.Context, env types.TxEnvelope) (common.Hash, error) {
+	weights, err := s.InspectTxEnvelope(ctx, env)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if !weights.Sender.Satisfied || (weights.FeePayer != nil && !weights.FeePayer.Satisfied) {
+		return common.Hash{}, errTxEnvelopeNotEnoughWeight
+	}
+	tx, err := env.Transaction()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return submitTransaction(ctx, s.b, tx)
+}
+
+func newSignatureWeight(weight, threshold uint) SignatureWeight {
+	return SignatureWeight{Weight: weight, Threshold: threshold, Satisfied: weight >= threshold}
+}
@@ -65,6 +65,7 @@ type Backend interface {
 	GetNonceInCache(address common.Address) (uint64, bool)
 
 	IsSenderTxHashIndexingEnabled() bool
+	GetAccountKeyHistory(addr common.Address) ([]*blockchain.AccountKeyChange, error)
 
 	// TxPool API
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
@@ -0,0 +1,189 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus"
+	"github.com/klaytn/klaytn/ser/rlp"
+)
+
+// AccountKeyChange is a change of the account key of an address made by an account creation
+// or an account update transaction.
+type AccountKeyChange struct {
+	BlockNumber uint64
+	TxHash      common.Hash
+	OldKey      accountkey.AccountKey // nil if the account did not exist before the transaction
+	NewKey      accountkey.AccountKey
+}
+
+// accountKeyChangeRLP is the storage format of AccountKeyChange.
+// An empty OldKey means that the account did not exist.
+type accountKeyChangeRLP struct {
+	BlockNumber uint64
+	TxHash      common.Hash
+	OldKey      []byte
+	NewKey      []byte
+}
+
+func encodeAccountKey(key accountkey.AccountKey) ([]byte, error) {
+	if key == nil {
+		return nil, nil
+	}
+	return rlp.EncodeToBytes(accountkey.NewAccountKeySerializerWithAccountKey(key))
+}
+
+func decodeAccountKey(data []byte) (accountkey.AccountKey, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	serializer := accountkey.NewAccountKeySerializer()
+	if err := rlp.DecodeBytes(data, serializer); err != nil {
+		return nil, err
+	}
+	return serializer.GetKey(), nil
+}
+
+// GetAccountKeyHistory returns the account key changes of the address in the order of the changes.
+// Only the changes in the blocks processed by this node while the indexing is enabled are included.
+// The changes made by the transactions which are not in the canonical chain are skipped.
+func (bc *BlockChain) GetAccountKeyHistory(addr common.Address) ([]*AccountKeyChange, error) {
+	if !bc.cacheConfig.AccountKeyHistoryIndexing {
+		return nil, ErrAccountKeyHistoryIndexingDisabled
+	}
+	stored, err := bc.readAccountKeyHistory(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*AccountKeyChange, 0, len(stored))
+	for _, s := range stored {
+		blockHash, blockNum, _ := bc.db.ReadTxLookupEntry(s.TxHash)
+		if blockNum != s.BlockNumber || blockHash == (common.Hash{}) || blockHash != bc.db.ReadCanonicalHash(blockNum) {
+			continue
+		}
+		change := &AccountKeyChange{BlockNumber: s.BlockNumber, TxHash: s.TxHash}
+		if change.OldKey, err = decodeAccountKey(s.OldKey); err != nil {
+			return nil, err
+		}
+		if change.NewKey, err = decodeAccountKey(s.NewKey); err != nil {
+			return nil, err
+		}
+		history = append(history, change)
+	}
+	return history, nil
+}
+
+func (bc *BlockChain) readAccountKeyHistory(addr common.Address) ([]accountKeyChangeRLP, error) {
+	var history []accountKeyChangeRLP
+	data := bc.db.ReadAccountKeyHistory(addr)
+	if data == nil {
+		return history, nil
+	}
+	if err := rlp.DecodeBytes(data, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// writeAccountKeyHistory appends the account key changes made by the successful transactions
+// of the canonical block to the history of each address. The old key of the first change of
+// an address in the block is read from the state of the parent block.
+func (bc *BlockChain) writeAccountKeyHistory(block *types.Block, receipts types.Receipts) {
+	if !bc.cacheConfig.AccountKeyHistoryIndexing {
+		return
+	}
+
+	var (
+		changes   = make(map[common.Address][]accountKeyChangeRLP)
+		addrs     []common.Address
+		lastKeys  = make(map[common.Address]accountkey.AccountKey)
+		parentDB  *state.StateDB
+		blockNum  = block.NumberU64()
+		parentErr error
+	)
+	for i, tx := range block.Transactions() {
+		newKey, ok := tx.AccountKey()
+		if !ok || i >= len(receipts) || receipts[i].Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		addr, err := tx.From()
+		if err != nil {
+			logger.Error("Failed to get the sender of an account key change", "txHash", tx.Hash(), "err", err)
+			continue
+		}
+		if tx.Type().IsAccountCreation() {
+			addr = *tx.To()
+		}
+
+		oldKey, ok := lastKeys[addr]
+		if !ok {
+			if parentDB == nil && parentErr == nil {
+				parentDB, parentErr = bc.parentState(block)
+				if parentErr != nil {
+					logger.Warn("Failed to read the old account keys", "blockNum", blockNum, "err", parentErr)
+				}
+			}
+			if parentDB != nil && parentDB.Exist(addr) {
+				oldKey = parentDB.GetKey(addr)
+			}
+			addrs = append(addrs, addr)
+		}
+		lastKeys[addr] = newKey
+
+		oldEnc, err := encodeAccountKey(oldKey)
+		if err != nil {
+			logger.Error("Failed to encode an account key", "txHash", tx.Hash(), "err", err)
+			continue
+		}
+		newEnc, err := encodeAccountKey(newKey)
+		if err != nil {
+			logger.Error("Failed to encode an account key", "txHash", tx.Hash(), "err", err)
+			continue
+		}
+		changes[addr] = append(changes[addr], accountKeyChangeRLP{blockNum, tx.Hash(), oldEnc, newEnc})
+	}
+
+	for _, addr := range addrs {
+		history, err := bc.readAccountKeyHistory(addr)
+		if err != nil {
+			logger.Error("Failed to read the account key history", "address", addr, "err", err)
+			continue
+		}
+		// Drop the changes of the blocks replaced by this block.
+		for len(history) > 0 && history[len(history)-1].BlockNumber >= blockNum {
+			history = history[:len(history)-1]
+		}
+		data, err := rlp.EncodeToBytes(append(history, changes[addr]...))
+		if err != nil {
+			logger.Error("Failed to encode the account key history", "address", addr, "err", err)
+			continue
+		}
+		bc.db.WriteAccountKeyHistory(addr, data)
+	}
+}
+
+func (bc *BlockChain) parentState(block *types.Block) (*state.StateDB, error) {
+	parent := bc.GetHeader(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	return bc.StateAt(parent.Root)
+}
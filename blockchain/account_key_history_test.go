@@ -0,0 +1,172 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"crypto/ecdsa"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/gxhash"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// TestAccountKeyHistory checks that the account key changes made by account update and
+// human-readable account creation transactions are indexed in order, and that the changes
+// in the blocks replaced by a reorganization are not returned.
+func TestAccountKeyHistory(t *testing.T) {
+	var (
+		key1, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		key2, _  = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+		key3, _  = crypto.HexToECDSA("49a7b37aa6f6645917e7b807e9d1c00d4fa71f18343b0d4122a4d2df64dd6fee")
+		addr1    = crypto.PubkeyToAddress(key1.PublicKey)
+		hra, _   = common.HumanReadableToAddress("colin.klaytn")
+		db       = database.NewMemoryDBManager()
+		config   = &params.ChainConfig{ChainID: params.TestChainConfig.ChainID, HumanReadableCompatibleBlock: big.NewInt(0), Gxhash: new(params.GxhashConfig)}
+		gspec    = &Genesis{Config: config, Alloc: GenesisAlloc{addr1: {Balance: big.NewInt(10000000000000)}}}
+		genesis  = gspec.MustCommit(db)
+		signer   = types.NewEIP155Signer(gspec.Config.ChainID)
+		pubKey2  = accountkey.NewAccountKeyPublicWithValue(&key2.PublicKey)
+		pubKey3  = accountkey.NewAccountKeyPublicWithValue(&key3.PublicKey)
+		gasLimit = uint64(1000000)
+	)
+
+	blockchain, _ := NewBlockChain(db, &CacheConfig{StateDBCaching: false, ArchiveMode: false, CacheSize: 512,
+		BlockInterval: DefaultBlockInterval, AccountKeyHistoryIndexing: true}, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	defer blockchain.Stop()
+
+	signTx := func(txType types.TxType, values map[types.TxValueKeyType]interface{}, key *ecdsa.PrivateKey) *types.Transaction {
+		tx, err := types.NewTransactionWithMap(txType, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tx.SignWithKeys(signer, []*ecdsa.PrivateKey{key}); err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	genAccountUpdateTx := func(nonce uint64, newKey accountkey.AccountKey, signKey *ecdsa.PrivateKey) *types.Transaction {
+		return signTx(types.TxTypeAccountUpdate, map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:      nonce,
+			types.TxValueKeyFrom:       addr1,
+			types.TxValueKeyGasLimit:   gasLimit,
+			types.TxValueKeyGasPrice:   new(big.Int),
+			types.TxValueKeyAccountKey: newKey,
+		}, signKey)
+	}
+
+	var txs []*types.Transaction
+	chain, _ := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, 3, func(i int, gen *BlockGen) {
+		switch i {
+		case 0:
+			txs = append(txs, genAccountUpdateTx(gen.TxNonce(addr1), pubKey2, key1))
+			gen.AddTx(txs[0])
+		case 2:
+			// Two changes of addr1 in a block and a creation of a human-readable account.
+			txs = append(txs, genAccountUpdateTx(gen.TxNonce(addr1), pubKey3, key2))
+			txs = append(txs, genAccountUpdateTx(gen.TxNonce(addr1)+1, pubKey2, key3))
+			txs = append(txs, signTx(types.TxTypeAccountCreation, map[types.TxValueKeyType]interface{}{
+				types.TxValueKeyNonce:         gen.TxNonce(addr1) + 2,
+				types.TxValueKeyFrom:          addr1,
+				types.TxValueKeyTo:            hra,
+				types.TxValueKeyAmount:        big.NewInt(1),
+				types.TxValueKeyGasLimit:      gasLimit + params.TxGasHumanReadable,
+				types.TxValueKeyGasPrice:      new(big.Int),
+				types.TxValueKeyHumanReadable: true,
+				types.TxValueKeyAccountKey:    pubKey3,
+			}, key2))
+			for _, tx := range txs[1:] {
+				gen.AddTx(tx)
+			}
+		}
+	})
+	if _, err := blockchain.InsertChain(chain); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+
+	history, err := blockchain.GetAccountKeyHistory(addr1)
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 3, len(history)) {
+		expected := []struct {
+			blockNum       uint64
+			oldKey, newKey accountkey.AccountKey
+		}{
+			{1, accountkey.NewAccountKeyLegacy(), pubKey2},
+			{3, pubKey2, pubKey3},
+			{3, pubKey3, pubKey2},
+		}
+		for i, e := range expected {
+			assert.Equal(t, e.blockNum, history[i].BlockNumber)
+			assert.Equal(t, txs[i].Hash(), history[i].TxHash)
+			assert.True(t, e.oldKey.Equal(history[i].OldKey))
+			assert.True(t, e.newKey.Equal(history[i].NewKey))
+		}
+	}
+
+	history, err = blockchain.GetAccountKeyHistory(hra)
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 1, len(history)) {
+		assert.Equal(t, txs[3].Hash(), history[0].TxHash)
+		assert.Nil(t, history[0].OldKey)
+		assert.True(t, pubKey3.Equal(history[0].NewKey))
+	}
+
+	// A longer fork without the third block drops the changes made in it.
+	fork, _ := GenerateChain(gspec.Config, chain[1], gxhash.NewFaker(), db, 2, func(i int, gen *BlockGen) {})
+	if _, err := blockchain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert forked chain: %v", err)
+	}
+	history, err = blockchain.GetAccountKeyHistory(addr1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(history))
+
+	history, err = blockchain.GetAccountKeyHistory(hra)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(history))
+
+	// The changes made in the side chain blocks are indexed when the side chain becomes canonical.
+	var forkTx *types.Transaction
+	fork, _ = GenerateChain(gspec.Config, chain[0], gxhash.NewFaker(), db, 4, func(i int, gen *BlockGen) {
+		if i == 0 {
+			forkTx = genAccountUpdateTx(gen.TxNonce(addr1), pubKey3, key2)
+			gen.AddTx(forkTx)
+		}
+	})
+	if _, err := blockchain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert forked chain: %v", err)
+	}
+	history, err = blockchain.GetAccountKeyHistory(addr1)
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 2, len(history)) {
+		assert.Equal(t, txs[0].Hash(), history[0].TxHash)
+		assert.Equal(t, forkTx.Hash(), history[1].TxHash)
+		assert.Equal(t, uint64(2), history[1].BlockNumber)
+		assert.True(t, pubKey2.Equal(history[1].OldKey))
+		assert.True(t, pubKey3.Equal(history[1].NewKey))
+	}
+
+	// The history is not available if the indexing is disabled.
+	blockchain.cacheConfig.AccountKeyHistoryIndexing = false
+	_, err = blockchain.GetAccountKeyHistory(addr1)
+	assert.Equal(t, ErrAccountKeyHistoryIndexingDisabled, err)
+}
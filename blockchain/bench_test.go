@@ -33,6 +33,7 @@ import (
 	"github.com/klaytn/klaytn/consensus/gxhash"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
 	"github.com/klaytn/klaytn/storage/database"
 
 	"crypto/ecdsa"
@@ -88,6 +89,21 @@ func BenchmarkInsertChain_ring1000_badgerDB(b *testing.B) {
 	benchInsertChain(b, database.BadgerDB, genTxRing(1000))
 }
 
+func BenchmarkInsertChain_contractCall_memDB(b *testing.B) {
+	benchInsertContractChain(b, database.MemoryDB, vm.Config{})
+}
+func BenchmarkInsertChain_contractCall_noCodeAnalysisCache_memDB(b *testing.B) {
+	benchInsertContractChain(b, database.MemoryDB, vm.Config{NoCodeAnalysisCache: true})
+}
+
+// BenchmarkInsertChain_feeDelegatedValueTx Series
+func BenchmarkInsertChain_feeDelegatedValueTx_memDB(b *testing.B) {
+	benchInsertFeeDelegatedChain(b, database.MemoryDB)
+}
+func BenchmarkInsertChain_feeDelegatedValueTx_levelDB(b *testing.B) {
+	benchInsertFeeDelegatedChain(b, database.LevelDB)
+}
+
 var (
 	// This is the content of the genesis block used by the benchmarks.
 	benchRootKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
@@ -151,7 +167,85 @@ func genTxRing(naccounts int) func(int, *BlockGen) {
 	}
 }
 
+var (
+	// benchContractCode loops 100 times and has 20kB of unreachable code, so that
+	// the code analysis takes time as much as a large contract.
+	benchContractCode = append([]byte{
+		byte(vm.PUSH1), 100, // counter
+		byte(vm.JUMPDEST), // loop: counter
+		byte(vm.PUSH1), 1, byte(vm.SWAP1), byte(vm.SUB),
+		byte(vm.DUP1), byte(vm.PUSH1), 2, byte(vm.JUMPI),
+		byte(vm.STOP),
+	}, make([]byte, 20*1024)...)
+	benchContractAddr = common.HexToAddress("0x0a00")
+)
+
+// genContractCall returns a block generator that includes n transactions calling
+// the benchmark contract in each block.
+func genContractCall(n int) func(int, *BlockGen) {
+	signer := types.NewEIP155Signer(params.TestChainConfig.ChainID)
+	return func(i int, gen *BlockGen) {
+		for j := 0; j < n; j++ {
+			tx := types.NewTransaction(gen.TxNonce(benchRootAddr), benchContractAddr, new(big.Int), 100000, nil, nil)
+			tx, _ = types.SignTx(tx, signer, benchRootKey)
+			gen.AddTx(tx)
+		}
+	}
+}
+
+// genFeeDelegatedValueTx returns a block generator that includes n fee-delegated
+// value-transfer transactions in each block, whose fees are paid by the second ring account.
+func genFeeDelegatedValueTx(n int) func(int, *BlockGen) {
+	signer := types.NewEIP155Signer(params.TestChainConfig.ChainID)
+	return func(i int, gen *BlockGen) {
+		for j := 0; j < n; j++ {
+			tx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+				types.TxValueKeyNonce:    gen.TxNonce(benchRootAddr),
+				types.TxValueKeyFrom:     benchRootAddr,
+				types.TxValueKeyTo:       common.Address{},
+				types.TxValueKeyAmount:   big.NewInt(1),
+				types.TxValueKeyGasLimit: params.TxGasValueTransfer + params.TxGasFeeDelegated,
+				types.TxValueKeyGasPrice: big.NewInt(1),
+				types.TxValueKeyFeePayer: ringAddrs[1],
+			})
+			if err != nil {
+				panic(err)
+			}
+			if err := tx.Sign(signer, benchRootKey); err != nil {
+				panic(err)
+			}
+			if err := tx.SignFeePayer(signer, ringKeys[1]); err != nil {
+				panic(err)
+			}
+			gen.AddTx(tx)
+		}
+	}
+}
+
+// benchInsertFeeDelegatedChain measures the insertion of blocks filled with fee-delegated
+// transactions, which need the recovery of both the sender and the fee payer.
+func benchInsertFeeDelegatedChain(b *testing.B, dbType database.DBType) {
+	alloc := GenesisAlloc{
+		benchRootAddr: {Balance: benchRootFunds},
+		ringAddrs[1]:  {Balance: benchRootFunds},
+	}
+	benchInsertChainWithConfig(b, dbType, alloc, genFeeDelegatedValueTx(100), vm.Config{})
+}
+
+// benchInsertContractChain measures the insertion of blocks filled with contract calls.
+func benchInsertContractChain(b *testing.B, dbType database.DBType, vmConfig vm.Config) {
+	alloc := GenesisAlloc{
+		benchRootAddr:     {Balance: benchRootFunds},
+		benchContractAddr: {Balance: new(big.Int), Code: benchContractCode},
+	}
+	benchInsertChainWithConfig(b, dbType, alloc, genContractCall(20), vmConfig)
+}
+
 func benchInsertChain(b *testing.B, dbType database.DBType, gen func(int, *BlockGen)) {
+	benchInsertChainWithConfig(b, dbType, GenesisAlloc{benchRootAddr: {Balance: benchRootFunds}}, gen, vm.Config{})
+}
+
+func benchInsertChainWithConfig(b *testing.B, dbType database.DBType, alloc GenesisAlloc, gen func(int, *BlockGen), vmConfig vm.Config) {
 	// 1. Create the database
 	dir := genTempDirForDB(b)
 	defer os.RemoveAll(dir)
@@ -162,14 +256,27 @@ func benchInsertChain(b *testing.B, dbType database.DBType, gen func(int, *Block
 	// 2. Generate a chain of b.N blocks using the supplied block generator function.
 	gspec := Genesis{
 		Config: params.TestChainConfig,
-		Alloc:  GenesisAlloc{benchRootAddr: {Balance: benchRootFunds}},
+		Alloc:  alloc,
 	}
 	genesis := gspec.MustCommit(db)
 	chain, _ := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), db, b.N, gen)
 
+	// Decode the blocks again to drop the senders cached while generating them,
+	// as the blocks received from peers.
+	for i, block := range chain {
+		enc, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			b.Fatal(err)
+		}
+		chain[i] = new(types.Block)
+		if err := rlp.DecodeBytes(enc, chain[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+
 	// Time the insertion of the new chain.
 	// State and blocks are stored in the same DB.
-	chainman, _ := NewBlockChain(db, nil, gspec.Config, gxhash.NewFaker(), vm.Config{})
+	chainman, _ := NewBlockChain(db, nil, gspec.Config, gxhash.NewFaker(), vmConfig)
 	defer chainman.Stop()
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -32,6 +32,7 @@ import (
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/fork"
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/metrics"
 	"github.com/klaytn/klaytn/params"
@@ -85,6 +86,9 @@ type CacheConfig struct {
 	BlockInterval        uint // Block interval to flush the trie. Each interval state trie will be flushed into disk.
 	TrieCacheLimit       int  // Memory allowance (MB) to use for caching trie nodes in memory
 	SenderTxHashIndexing bool // Enables saving senderTxHash to txHash mapping information to database and cache.
+
+	AccountKeyHistoryIndexing bool // Enables saving the history of account key changes to database.
+	ParallelTxExecution       bool // Enables executing the transactions of a block in parallel.
 }
 
 // BlockChain represents the canonical chain given a database with a genesis
@@ -167,6 +171,11 @@ func NewBlockChain(db database.DBManager, cacheConfig *CacheConfig, chainConfig
 	// Initialize DeriveSha implementation
 	InitDeriveSha(chainConfig.DeriveShaImpl)
 
+	// Set the hard fork block numbers for the packages which only know the block number
+	if err := fork.SetHardForkBlockNumberConfig(chainConfig); err != nil {
+		return nil, err
+	}
+
 	futureBlocks, _ := lru.New(maxFutureBlocks)
 	badBlocks, _ := lru.New(maxBadBlocks)
 
@@ -1175,6 +1184,7 @@ func (bc *BlockChain) writeBlockWithStateSerial(block *types.Block, receipts []*
 			return NonStatTy, err
 		}
 		bc.db.WritePreimages(block.NumberU64(), state.Preimages())
+		bc.writeAccountKeyHistory(block, receipts)
 		status = CanonStatTy
 	} else {
 		status = SideStatTy
@@ -1261,7 +1271,7 @@ func (bc *BlockChain) writeBlockWithStateParallel(block *types.Block, receipts [
 			}
 		}
 
-		parallelDBWriteWG.Add(2)
+		parallelDBWriteWG.Add(3)
 
 		go func() {
 			defer parallelDBWriteWG.Done()
@@ -1276,6 +1286,11 @@ func (bc *BlockChain) writeBlockWithStateParallel(block *types.Block, receipts [
 			bc.db.WritePreimages(block.NumberU64(), state.Preimages())
 		}()
 
+		go func() {
+			defer parallelDBWriteWG.Done()
+			bc.writeAccountKeyHistory(block, receipts)
+		}()
+
 		// Wait until all writing goroutines are terminated.
 		parallelDBWriteWG.Wait()
 
@@ -1389,8 +1404,8 @@ func (bc *BlockChain) insertChain(chain types.Blocks) (int, []interface{}, []*ty
 	abort, results := bc.engine.VerifyHeaders(bc, headers, seals)
 	defer close(abort)
 
-	// Start a parallel signature recovery (signer will fluke on fork transition, minimal perf loss)
-	senderCacher.recoverFromBlocks(types.MakeSigner(bc.chainConfig, chain[0].Number()), chain)
+	// Start a parallel recovery of the senders and the fee payers of the transactions
+	recovered := senderCacher.recoverFromBlocks(bc.chainConfig, chain)
 
 	// Iterate over the blocks and insert when the verifier permits
 	for i, block := range chain {
@@ -1486,6 +1501,12 @@ func (bc *BlockChain) insertChain(chain types.Blocks) (int, []interface{}, []*ty
 			return i, events, coalescedLogs, err
 		}
 
+		// Wait for the senders of the block to be recovered, so that the state
+		// transition only validates them against the account keys.
+		waitStart := time.Now()
+		recovered[i].Wait()
+		senderRecoveryWaitTimer.UpdateSince(waitStart)
+
 		// for debug
 		start := time.Now()
 
@@ -1687,6 +1708,10 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 		bc.insert(newChain[i])
 		// write lookup entries for hash based transaction/receipt searches
 		bc.db.WriteTxLookupEntries(newChain[i])
+		// index the account key changes of the side chain blocks, the new head block is indexed by the caller
+		if i > 0 {
+			bc.writeAccountKeyHistory(newChain[i], bc.db.ReadReceipts(newChain[i].Hash(), newChain[i].NumberU64()))
+		}
 		addedTxs = append(addedTxs, newChain[i].Transactions()...)
 	}
 	// calculate the difference between deleted and added transactions
@@ -1911,6 +1936,9 @@ func (bc *BlockChain) Config() *params.ChainConfig { return bc.chainConfig }
 // Engine retrieves the blockchain's consensus engine.
 func (bc *BlockChain) Engine() consensus.Engine { return bc.engine }
 
+// GetVMConfig returns the block chain VM config.
+func (bc *BlockChain) GetVMConfig() *vm.Config { return &bc.vmConfig }
+
 // SubscribeRemovedLogsEvent registers a subscription of RemovedLogsEvent.
 func (bc *BlockChain) SubscribeRemovedLogsEvent(ch chan<- RemovedLogsEvent) event.Subscription {
 	return bc.scope.Track(bc.rmLogsFeed.Subscribe(ch))
@@ -1954,6 +1982,12 @@ func (bc *BlockChain) IsSenderTxHashIndexingEnabled() bool {
 	return bc.cacheConfig.SenderTxHashIndexing
 }
 
+// IsAccountKeyHistoryIndexingEnabled returns if storing the history of account key changes
+// is enabled or not.
+func (bc *BlockChain) IsAccountKeyHistoryIndexingEnabled() bool {
+	return bc.cacheConfig.AccountKeyHistoryIndexing
+}
+
 // GetNonceCache returns a nonceCache.
 func (bc *BlockChain) GetNonceCache() common.Cache {
 	return bc.nonceCache
@@ -1996,6 +2030,9 @@ func (bc *BlockChain) ApplyTransaction(config *params.ChainConfig, author *commo
 	blockNumber := header.Number.Uint64()
 
 	// validation for each transaction before execution
+	if err := tx.ValidateActivated(config.Rules(header.Number)); err != nil {
+		return nil, 0, err
+	}
 	if err := tx.Validate(statedb, blockNumber); err != nil {
 		return nil, 0, err
 	}
@@ -108,4 +108,7 @@ var (
 
 	// ErrAccountCreationPrevented is returned if account creation is inserted in the service chain's txpool.
 	ErrAccountCreationPrevented = errors.New("account creation is prevented for the service chain")
+
+	// ErrAccountKeyHistoryIndexingDisabled is returned if the account key history is requested without the indexing.
+	ErrAccountKeyHistoryIndexingDisabled = errors.New("account key history indexing is disabled")
 )
@@ -223,7 +223,7 @@ func SetupGenesisBlock(db database.DBManager, genesis *Genesis, networkId uint64
 		return newcfg, stored, fmt.Errorf("missing block number for head header hash")
 	}
 	compatErr := storedcfg.CheckCompatible(newcfg, *height)
-	if compatErr != nil && *height != 0 && compatErr.RewindTo != 0 {
+	if compatErr != nil && *height != 0 {
 		return newcfg, stored, compatErr
 	}
 	db.WriteChainConfig(stored, newcfg)
@@ -26,7 +26,9 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/klaytn/klaytn/blockchain/vm"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/gxhash"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 )
@@ -169,3 +171,93 @@ func TestSetupGenesis(t *testing.T) {
 		}
 	}
 }
+
+// TestSetupGenesisHardFork checks that a hard fork can be rescheduled only if the chain head
+// has not reached it, and that the rules of the stored config are applied by the blockchain.
+func TestSetupGenesisHardFork(t *testing.T) {
+	newGenesis := func(istanbulBlock int64) *Genesis {
+		return &Genesis{
+			Config: &params.ChainConfig{
+				ChainID:                 big.NewInt(1),
+				IstanbulCompatibleBlock: big.NewInt(istanbulBlock),
+				Governance:              &params.GovernanceConfig{Reward: &params.RewardConfig{}},
+			},
+			Alloc: GenesisAlloc{{1}: {Balance: big.NewInt(1)}},
+		}
+	}
+
+	db := database.NewMemoryDBManager()
+	oldGenesis := newGenesis(2)
+	genesis := oldGenesis.MustCommit(db)
+
+	bc, err := NewBlockChain(db, nil, oldGenesis.Config, gxhash.NewFullFaker(), vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bc.Stop()
+
+	if bc.Config().Rules(big.NewInt(1)).IsIstanbul || !bc.Config().Rules(big.NewInt(2)).IsIstanbul {
+		t.Errorf("the Istanbul fork should be activated at block 2")
+	}
+
+	// Advance to block #4, past the Istanbul fork block of the stored config.
+	blocks, _ := GenerateChain(oldGenesis.Config, genesis, gxhash.NewFaker(), db, 4, nil)
+	if _, err := bc.InsertChain(blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	// Moving a fork block the head has passed is refused.
+	_, _, err = SetupGenesisBlock(db, newGenesis(3), params.UnusedNetworkId, false)
+	wantErr := &params.ConfigCompatError{
+		What:         "Istanbul fork block",
+		StoredConfig: big.NewInt(2),
+		NewConfig:    big.NewInt(3),
+		RewindTo:     1,
+	}
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Errorf("returned error %v, want %v", err, wantErr)
+	}
+	if stored := db.ReadChainConfig(genesis.Hash()); stored.IstanbulCompatibleBlock.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("the stored config should not be updated, got %v", stored.IstanbulCompatibleBlock)
+	}
+
+	// Moving a fork block is refused even if the chain would be rewound to the genesis block.
+	_, _, err = SetupGenesisBlock(db, newGenesis(1), params.UnusedNetworkId, false)
+	if compatErr, ok := err.(*params.ConfigCompatError); !ok || compatErr.RewindTo != 0 {
+		t.Errorf("returned error %v, want a compatibility error rewinding to the genesis block", err)
+	}
+
+	// The fork block can be moved after the chain is rewound to the block before the fork.
+	bc.SetHead(wantErr.RewindTo)
+	if _, _, err := SetupGenesisBlock(db, newGenesis(3), params.UnusedNetworkId, false); err != nil {
+		t.Fatal(err)
+	}
+	if stored := db.ReadChainConfig(genesis.Hash()); stored.IstanbulCompatibleBlock.Cmp(big.NewInt(3)) != 0 {
+		t.Errorf("the stored config should be updated after rewinding, got %v", stored.IstanbulCompatibleBlock)
+	}
+
+	// Scheduling a fork block after the head is accepted.
+	db2 := database.NewMemoryDBManager()
+	laterGenesis := newGenesis(10)
+	genesis = laterGenesis.MustCommit(db2)
+	blocks, _ = GenerateChain(laterGenesis.Config, genesis, gxhash.NewFaker(), db2, 4, nil)
+	bc2, err := NewBlockChain(db2, nil, laterGenesis.Config, gxhash.NewFullFaker(), vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bc2.Stop()
+	if _, err := bc2.InsertChain(blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	config, _, err := SetupGenesisBlock(db2, newGenesis(20), params.UnusedNetworkId, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.IstanbulCompatibleBlock.Cmp(big.NewInt(20)) != 0 {
+		t.Errorf("returned config has the Istanbul fork block %v, want 20", config.IstanbulCompatibleBlock)
+	}
+	if stored := db2.ReadChainConfig(genesis.Hash()); stored.IstanbulCompatibleBlock.Cmp(big.NewInt(20)) != 0 {
+		t.Errorf("the stored config should be updated, got %v", stored.IstanbulCompatibleBlock)
+	}
+}
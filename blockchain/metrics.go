@@ -38,6 +38,11 @@ var (
 	blockTxCountsGauge   = metrics.NewRegisteredGauge("blockchain/block/tx/gauge", nil)
 	blockTxCountsCounter = metrics.NewRegisteredCounter("blockchain/block/tx/counter", nil)
 
+	parallelTxMergedMeter     = metrics.NewRegisteredMeter("blockchain/parallel/tx/merged", nil)
+	parallelTxReexecutedMeter = metrics.NewRegisteredMeter("blockchain/parallel/tx/reexecuted", nil)
+
+	senderRecoveryWaitTimer = metrics.NewRegisteredTimer("blockchain/sender/recovery/wait", nil)
+
 	txPoolPendingGauge = metrics.NewRegisteredGauge("tx/pool/pending/gauge", nil)
 	txPoolQueueGauge   = metrics.NewRegisteredGauge("tx/pool/queue/gauge", nil)
 )
@@ -0,0 +1,123 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"runtime"
+	"sync"
+)
+
+// speculativeResult is the result of a transaction executed on a copy of the state
+// at the start of the block.
+type speculativeResult struct {
+	view    *state.StateDB
+	set     *state.AccessSet
+	receipt *types.Receipt
+	gas     uint64
+	err     error
+}
+
+// applyTransactionsInParallel applies the transactions of the block to statedb, and
+// returns the same receipts and state as applying them one by one.
+//
+// Each transaction is executed speculatively on its own copy of statedb in parallel,
+// recording the accounts it reads and writes. Then the results are merged to statedb
+// in order. If a transaction has read an account written by a preceding transaction,
+// or it has failed, its result is discarded and it is executed again on statedb.
+func (bc *BlockChain) applyTransactionsInParallel(block *types.Block, statedb *state.StateDB, author *common.Address, usedGas *uint64, cfg *vm.Config) (types.Receipts, []*types.Log, error) {
+	var (
+		txs     = block.Transactions()
+		header  = block.Header()
+		results = make([]speculativeResult, len(txs))
+		views   = make([]*state.StateDB, len(txs))
+	)
+	// Copy the state before executing any transaction.
+	for i := range txs {
+		views[i] = statedb.Copy()
+	}
+
+	var (
+		wg      sync.WaitGroup
+		next    = make(chan int, len(txs))
+		workers = runtime.NumCPU()
+	)
+	for i := range txs {
+		next <- i
+	}
+	close(next)
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = bc.applyTransactionSpeculatively(header, block.Hash(), i, txs[i], views[i], author, cfg)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var (
+		receipts types.Receipts
+		allLogs  []*types.Log
+		written  = make(map[common.Address]struct{}) // accounts written by the merged transactions
+	)
+	for i, tx := range txs {
+		result := &results[i]
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+
+		if result.err == nil && result.view.Error() == nil && !result.set.ReadsAny(written) {
+			statedb.Merge(result.view, result.set)
+			parallelTxMergedMeter.Mark(1)
+		} else {
+			result.set = state.NewAccessSet()
+			statedb.SetAccessSet(result.set)
+			result.receipt, result.gas, result.err = bc.ApplyTransaction(bc.chainConfig, author, statedb, header, tx, new(uint64), cfg)
+			statedb.SetAccessSet(nil)
+			if result.err != nil {
+				return nil, nil, result.err
+			}
+			parallelTxReexecutedMeter.Mark(1)
+		}
+		for addr := range result.set.Writes() {
+			written[addr] = struct{}{}
+		}
+		results[i].view = nil // Release the copied state
+
+		*usedGas += result.gas
+		receipts = append(receipts, result.receipt)
+		allLogs = append(allLogs, result.receipt.Logs...)
+	}
+	return receipts, allLogs, nil
+}
+
+// applyTransactionSpeculatively applies the transaction to view recording the accounts accessed.
+func (bc *BlockChain) applyTransactionSpeculatively(header *types.Header, blockHash common.Hash, index int, tx *types.Transaction, view *state.StateDB, author *common.Address, cfg *vm.Config) speculativeResult {
+	set := state.NewAccessSet()
+	view.SetAccessSet(set)
+	view.Prepare(tx.Hash(), blockHash, index)
+
+	receipt, gas, err := bc.ApplyTransaction(bc.chainConfig, author, view, header, tx, new(uint64), cfg)
+	view.SetAccessSet(nil)
+	return speculativeResult{view: view, set: set, receipt: receipt, gas: gas, err: err}
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"crypto/ecdsa"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/gxhash"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// TestParallelTxExecution checks that the parallel executor produces the same state and
+// receipts as the sequential one, with blocks mixing independent and conflicting transactions.
+func TestParallelTxExecution(t *testing.T) {
+	var (
+		numAccounts = 16
+		keys        = make([]*ecdsa.PrivateKey, numAccounts)
+		addrs       = make([]common.Address, numAccounts)
+		alloc       = GenesisAlloc{}
+		gasPrice    = big.NewInt(1)
+		signer      = types.NewEIP155Signer(params.TestChainConfig.ChainID)
+
+		// counter increments the storage slot 0 and emits a log.
+		counter     = []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x60, 0x00, 0x60, 0x00, 0xa0, 0x00}
+		counterAddr = common.HexToAddress("0x0c0c")
+		// initCode deploys counter.
+		initCode = append([]byte{0x60, byte(len(counter)), 0x80, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}, counter...)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		alloc[addrs[i]] = GenesisAccount{Balance: big.NewInt(params.KLAY)}
+	}
+	alloc[counterAddr] = GenesisAccount{Balance: new(big.Int), Code: counter}
+
+	newChain := func(parallel bool) *BlockChain {
+		db := database.NewMemoryDBManager()
+		gspec := &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+		gspec.MustCommit(db)
+		cacheConfig := &CacheConfig{ArchiveMode: false, CacheSize: 512, BlockInterval: DefaultBlockInterval, ParallelTxExecution: parallel}
+		bc, err := NewBlockChain(db, cacheConfig, gspec.Config, gxhash.NewFaker(), vm.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bc
+	}
+
+	genDB := database.NewMemoryDBManager()
+	gspec := &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	genesis := gspec.MustCommit(genDB)
+	blocks, _ := GenerateChain(gspec.Config, genesis, gxhash.NewFaker(), genDB, 4, func(i int, gen *BlockGen) {
+		addTx := func(from int, tx *types.Transaction) {
+			signed, err := types.SignTx(tx, signer, keys[from])
+			if err != nil {
+				t.Fatal(err)
+			}
+			gen.AddTx(signed)
+		}
+		for j := 0; j < numAccounts; j++ {
+			switch j % 4 {
+			case 0: // Independent transfers to new accounts
+				addTx(j, types.NewTransaction(gen.TxNonce(addrs[j]), common.BigToAddress(big.NewInt(int64(0x100000+1000*i+j))), big.NewInt(1), params.TxGas, gasPrice, nil))
+			case 1: // Transfers to the next account, conflicting with the transactions of the next account
+				addTx(j, types.NewTransaction(gen.TxNonce(addrs[j]), addrs[(j+1)%numAccounts], big.NewInt(1000), params.TxGas, gasPrice, nil))
+			case 2: // Calls of the same contract
+				addTx(j, types.NewTransaction(gen.TxNonce(addrs[j]), counterAddr, new(big.Int), 100000, gasPrice, nil))
+			case 3: // Consecutive transactions of a sender including a contract creation
+				addTx(j, types.NewContractCreation(gen.TxNonce(addrs[j]), new(big.Int), 200000, gasPrice, initCode))
+				addTx(j, types.NewTransaction(gen.TxNonce(addrs[j]), addrs[j], big.NewInt(1), params.TxGas, gasPrice, nil))
+			}
+		}
+	})
+
+	sequential := newChain(false)
+	defer sequential.Stop()
+	parallel := newChain(true)
+	defer parallel.Stop()
+
+	if _, err := sequential.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain sequentially: %v", err)
+	}
+	// The state root and the receipts hash of each block are validated on insertion.
+	if _, err := parallel.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain in parallel: %v", err)
+	}
+
+	for _, block := range blocks {
+		expected := sequential.GetReceiptsByBlockHash(block.Hash())
+		actual := parallel.GetReceiptsByBlockHash(block.Hash())
+		if assert.Equal(t, len(expected), len(actual)) {
+			for i := range expected {
+				assert.Equal(t, expected[i].Status, actual[i].Status)
+				assert.Equal(t, expected[i].GasUsed, actual[i].GasUsed)
+				assert.Equal(t, expected[i].ContractAddress, actual[i].ContractAddress)
+				assert.Equal(t, expected[i].Logs, actual[i].Logs)
+			}
+		}
+	}
+	assert.Equal(t, sequential.CurrentBlock().Root(), parallel.CurrentBlock().Root())
+}
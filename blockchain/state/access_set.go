@@ -0,0 +1,134 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"math/big"
+)
+
+// AccessSet records the accounts read and written through a StateDB. It is used to
+// detect the conflicts between transactions executed in parallel.
+//
+// An account whose balance is only increased, like the rewardbase receiving the
+// transaction fee, is not recorded as read, so that the increases of the transactions
+// do not conflict with each other.
+type AccessSet struct {
+	reads  map[common.Address]struct{}
+	writes map[common.Address]struct{}
+	adds   map[common.Address]*big.Int // sum of the balance increases of each account
+
+	adding bool // whether AddBalance is loading the account
+}
+
+// NewAccessSet returns an empty AccessSet.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		reads:  make(map[common.Address]struct{}),
+		writes: make(map[common.Address]struct{}),
+		adds:   make(map[common.Address]*big.Int),
+	}
+}
+
+func (s *AccessSet) read(addr common.Address) {
+	if !s.adding {
+		s.reads[addr] = struct{}{}
+	}
+}
+
+func (s *AccessSet) add(addr common.Address, amount *big.Int) {
+	if sum, ok := s.adds[addr]; ok {
+		sum.Add(sum, amount)
+	} else {
+		s.adds[addr] = new(big.Int).Set(amount)
+	}
+}
+
+// addOnly returns true if the balance of the account is increased without reading it.
+func (s *AccessSet) addOnly(addr common.Address) bool {
+	_, read := s.reads[addr]
+	_, added := s.adds[addr]
+	return added && !read
+}
+
+// Writes returns the accounts written.
+func (s *AccessSet) Writes() map[common.Address]struct{} {
+	return s.writes
+}
+
+// ReadsAny returns true if any of the given accounts is read.
+func (s *AccessSet) ReadsAny(addrs map[common.Address]struct{}) bool {
+	if len(addrs) < len(s.reads) {
+		for addr := range addrs {
+			if _, ok := s.reads[addr]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	for addr := range s.reads {
+		if _, ok := addrs[addr]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAccessSet starts recording the accesses of accounts to the given set.
+// A nil set stops the recording.
+func (self *StateDB) SetAccessSet(set *AccessSet) {
+	self.accessSet = set
+}
+
+// Merge applies the changes of a transaction, executed on view copied from self and
+// finalised, to self. The accounts read by the transaction should not have been
+// changed in self since view was copied. The balance increases of the accounts not
+// read are applied on the current balances.
+func (self *StateDB) Merge(view *StateDB, set *AccessSet) {
+	for addr := range set.writes {
+		if set.addOnly(addr) {
+			self.AddBalance(addr, set.adds[addr])
+			continue
+		}
+		object, exist := view.stateObjects[addr]
+		if !exist {
+			continue
+		}
+		object = object.deepCopy(self)
+		self.setStateObject(object)
+		if object.deleted {
+			self.deleteStateObject(object)
+		} else {
+			self.updateStateObject(object)
+		}
+		self.stateObjectsDirty[addr] = struct{}{}
+	}
+	for hash, logs := range view.logs {
+		if _, exist := self.logs[hash]; exist {
+			continue // The logs of the transactions before view was copied
+		}
+		for _, log := range logs {
+			log.Index = self.logSize
+			self.logSize++
+		}
+		self.logs[hash] = logs
+	}
+	for hash, preimage := range view.preimages {
+		self.preimages[hash] = preimage
+	}
+	self.Finalise(true)
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// TestAccessSetMerge checks the accounts recorded by AccessSet, and that Merge applies
+// the balance increases of the accounts not read on their current balances.
+func TestAccessSetMerge(t *testing.T) {
+	var (
+		sender     = common.HexToAddress("0x1000")
+		recipient  = common.HexToAddress("0x2000")
+		rewardbase = common.HexToAddress("0x3000")
+	)
+	statedb, _ := New(common.Hash{}, NewDatabase(database.NewMemoryDBManager()))
+	statedb.AddBalance(sender, big.NewInt(100))
+	statedb.AddBalance(rewardbase, big.NewInt(100))
+	statedb.Finalise(true)
+
+	view := statedb.Copy()
+	set := NewAccessSet()
+	view.SetAccessSet(set)
+	view.SubBalance(sender, big.NewInt(10))
+	view.AddBalance(recipient, big.NewInt(9))
+	view.AddBalance(rewardbase, big.NewInt(1))
+	view.Finalise(true)
+	view.SetAccessSet(nil)
+
+	assert.True(t, set.ReadsAny(map[common.Address]struct{}{sender: {}}))
+	assert.False(t, set.ReadsAny(map[common.Address]struct{}{recipient: {}, rewardbase: {}}))
+	assert.Equal(t, 3, len(set.Writes()))
+
+	// The rewardbase is changed after the view is copied.
+	statedb.AddBalance(rewardbase, big.NewInt(50))
+	statedb.Finalise(true)
+
+	statedb.Merge(view, set)
+	assert.Equal(t, big.NewInt(90), statedb.GetBalance(sender))
+	assert.Equal(t, big.NewInt(9), statedb.GetBalance(recipient))
+	assert.Equal(t, big.NewInt(151), statedb.GetBalance(rewardbase))
+}
@@ -176,6 +176,25 @@ func (self *stateObject) GetState(db Database, key common.Hash) common.Hash {
 		return value
 	}
 	// Load from DB in case it is missing.
+	value = self.readStorageTrie(db, key)
+	self.cachedStorage[key] = value
+	return value
+}
+
+// GetCommittedState returns the value of account storage at the beginning of the
+// current transaction, ignoring the modifications made by the transaction.
+func (self *stateObject) GetCommittedState(db Database, key common.Hash) common.Hash {
+	// The storage trie is updated when a transaction is finalised, so it has the
+	// committed value of a slot modified by the current transaction.
+	if _, dirty := self.dirtyStorage[key]; dirty {
+		return self.readStorageTrie(db, key)
+	}
+	return self.GetState(db, key)
+}
+
+// readStorageTrie reads a value of the given key from the storage trie.
+func (self *stateObject) readStorageTrie(db Database, key common.Hash) common.Hash {
+	var value common.Hash
 	enc, err := self.getStorageTrie(db).TryGet(key[:])
 	if err != nil {
 		self.setError(err)
@@ -188,7 +207,6 @@ func (self *stateObject) GetState(db Database, key common.Hash) common.Hash {
 		}
 		value.SetBytes(content)
 	}
-	self.cachedStorage[key] = value
 	return value
 }
 
@@ -416,9 +434,9 @@ func (self *stateObject) Balance() *big.Int {
 	return self.account.GetBalance()
 }
 
-//func (self *stateObject) HumanReadable() bool {
-//	return self.account.GetHumanReadable()
-//}
+func (self *stateObject) HumanReadable() bool {
+	return self.account.GetHumanReadable()
+}
 
 func (self *stateObject) Nonce() uint64 {
 	return self.account.GetNonce()
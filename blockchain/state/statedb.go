@@ -91,6 +91,9 @@ type StateDB struct {
 	validRevisions []revision
 	nextRevisionId int
 
+	// accessSet records the accounts accessed, if it is set.
+	accessSet *AccessSet
+
 	lock sync.Mutex
 }
 
@@ -262,6 +265,16 @@ func (self *StateDB) AddRefund(gas uint64) {
 	self.refund += gas
 }
 
+// SubRefund removes gas from the refund counter.
+// This method will panic if the refund counter goes below zero.
+func (self *StateDB) SubRefund(gas uint64) {
+	self.journal.append(refundChange{prev: self.refund})
+	if gas > self.refund {
+		panic("Refund counter below zero")
+	}
+	self.refund -= gas
+}
+
 // Exist reports whether the given account address exists in the state.
 // Notably this also returns true for suicided accounts.
 func (self *StateDB) Exist(addr common.Address) bool {
@@ -316,13 +329,13 @@ func (self *StateDB) IsContractAccount(addr common.Address) bool {
 	return false
 }
 
-//func (self *StateDB) IsHumanReadable(addr common.Address) bool {
-//	stateObject := self.getStateObject(addr)
-//	if stateObject != nil {
-//		return stateObject.HumanReadable()
-//	}
-//	return false
-//}
+func (self *StateDB) IsHumanReadable(addr common.Address) bool {
+	stateObject := self.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.HumanReadable()
+	}
+	return false
+}
 
 func (self *StateDB) GetCodeSize(addr common.Address) int {
 	stateObject := self.getStateObject(addr)
@@ -355,6 +368,15 @@ func (self *StateDB) GetState(addr common.Address, bhash common.Hash) common.Has
 	return common.Hash{}
 }
 
+// GetCommittedState retrieves a value from the given account's committed storage trie.
+func (self *StateDB) GetCommittedState(addr common.Address, hash common.Hash) common.Hash {
+	stateObject := self.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.GetCommittedState(self.db, hash)
+	}
+	return common.Hash{}
+}
+
 // IsContractAvailable returns true if the account corresponding to the given address implements ProgramAccount.
 func (self *StateDB) IsContractAvailable(addr common.Address) bool {
 	stateObject := self.getStateObject(addr)
@@ -423,6 +445,11 @@ func (self *StateDB) HasSuicided(addr common.Address) bool {
 
 // AddBalance adds amount to the account associated with addr.
 func (self *StateDB) AddBalance(addr common.Address, amount *big.Int) {
+	if self.accessSet != nil {
+		self.accessSet.add(addr, amount)
+		self.accessSet.adding = true
+		defer func() { self.accessSet.adding = false }()
+	}
 	stateObject := self.GetOrNewStateObject(addr)
 	if stateObject != nil {
 		stateObject.AddBalance(amount)
@@ -539,6 +566,10 @@ func (self *StateDB) deleteStateObject(stateObject *stateObject) {
 
 // Retrieve a state object given by the address. Returns nil if not found.
 func (self *StateDB) getStateObject(addr common.Address) *stateObject {
+	if self.accessSet != nil {
+		self.accessSet.read(addr)
+	}
+
 	// First, check stateObjects if there is "live" object.
 	if obj := self.stateObjects[addr]; obj != nil {
 		if obj.deleted {
@@ -828,6 +859,9 @@ func (s *StateDB) Finalise(deleteEmptyObjects bool) {
 			// Thus, we can safely ignore it here
 			continue
 		}
+		if s.accessSet != nil {
+			s.accessSet.writes[addr] = struct{}{}
+		}
 
 		if stateObject.suicided || (deleteEmptyObjects && stateObject.empty()) {
 			s.deleteStateObject(stateObject)
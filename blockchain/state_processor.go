@@ -68,14 +68,22 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 	author, _ := p.bc.Engine().Author(header) // Ignore error, we're past header validation
 
 	// Iterate over and process the individual transactions
-	for i, tx := range block.Transactions() {
-		statedb.Prepare(tx.Hash(), block.Hash(), i)
-		receipt, _, err := p.bc.ApplyTransaction(p.config, &author, statedb, header, tx, usedGas, &cfg)
+	if p.bc.cacheConfig.ParallelTxExecution && !cfg.Debug {
+		var err error
+		receipts, allLogs, err = p.bc.applyTransactionsInParallel(block, statedb, &author, usedGas, &cfg)
 		if err != nil {
 			return nil, nil, 0, err
 		}
-		receipts = append(receipts, receipt)
-		allLogs = append(allLogs, receipt.Logs...)
+	} else {
+		for i, tx := range block.Transactions() {
+			statedb.Prepare(tx.Hash(), block.Hash(), i)
+			receipt, _, err := p.bc.ApplyTransaction(p.config, &author, statedb, header, tx, usedGas, &cfg)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			receipts = append(receipts, receipt)
+			allLogs = append(allLogs, receipt.Logs...)
+		}
 	}
 
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
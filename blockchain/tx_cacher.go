@@ -22,8 +22,10 @@ package blockchain
 
 import (
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/params"
 	"math"
 	"runtime"
+	"sync"
 )
 
 // senderCacher is a concurrent tranaction sender recoverer anc cacher.
@@ -40,10 +42,13 @@ func calcNumSenderCachers() int {
 // The inc field defines the number of transactions to skip after each recovery,
 // which is used to feed the same underlying input array to different threads but
 // ensure they process the early transactions fast.
+//
+// The done field, if not nil, is notified when the request is processed.
 type txSenderCacherRequest struct {
 	signer types.Signer
 	txs    []*types.Transaction
 	inc    int
+	done   *sync.WaitGroup
 }
 
 // txSenderCacher is a helper structure to concurrently ecrecover transaction
@@ -90,6 +95,9 @@ func (cacher *txSenderCacher) cache() {
 		for i := 0; i < len(task.txs); i += task.inc {
 			cacheSender(task.signer, task.txs[i])
 		}
+		if task.done != nil {
+			task.done.Done()
+		}
 	}
 }
 
@@ -97,6 +105,12 @@ func (cacher *txSenderCacher) cache() {
 // back into the same data structures. There is no validation being done, nor
 // any reaction to invalid signatures. That is up to calling code later.
 func (cacher *txSenderCacher) recover(signer types.Signer, txs []*types.Transaction) {
+	cacher.schedule(signer, txs, nil)
+}
+
+// schedule splits the recovery of the transactions into tasks and sends them to
+// the caching threads. If done is not nil, it is notified of each task processed.
+func (cacher *txSenderCacher) schedule(signer types.Signer, txs []*types.Transaction, done *sync.WaitGroup) {
 	// If there's nothing to recover, abort
 	if len(txs) == 0 {
 		return
@@ -106,26 +120,39 @@ func (cacher *txSenderCacher) recover(signer types.Signer, txs []*types.Transact
 	if len(txs) < tasks*4 {
 		tasks = (len(txs) + 3) / 4
 	}
+	if done != nil {
+		done.Add(tasks)
+	}
 	for i := 0; i < tasks; i++ {
 		cacher.tasks <- &txSenderCacherRequest{
 			signer: signer,
 			txs:    txs[i:],
 			inc:    tasks,
+			done:   done,
 		}
 	}
 }
 
-// recoverFromBlocks recovers the senders from a batch of blocks and caches them
-// back into the same data structures. There is no validation being done, nor
-// any reaction to invalid signatures. That is up to calling code later.
-func (cacher *txSenderCacher) recoverFromBlocks(signer types.Signer, blocks []*types.Block) {
-	count := 0
-	for _, block := range blocks {
-		count += len(block.Transactions())
-	}
-	txs := make([]*types.Transaction, 0, count)
-	for _, block := range blocks {
-		txs = append(txs, block.Transactions()...)
+// recoverFromBlocks recovers the senders and the fee payers of the transactions
+// in a batch of blocks and caches them back into the same data structures, so
+// that the state transition only needs to validate them against the account keys.
+// There is no validation being done, nor any reaction to invalid signatures.
+// That is up to calling code later.
+//
+// The recoveries are scheduled block by block in the background, and the returned
+// wait groups are done when the transactions of the corresponding blocks are recovered.
+func (cacher *txSenderCacher) recoverFromBlocks(config *params.ChainConfig, blocks []*types.Block) []*sync.WaitGroup {
+	recovered := make([]*sync.WaitGroup, len(blocks))
+	for i := range blocks {
+		recovered[i] = new(sync.WaitGroup)
+		// Add the tasks of the block in advance, so that waiting never passes a block not scheduled yet.
+		recovered[i].Add(1)
 	}
-	cacher.recover(signer, txs)
+	go func() {
+		for i, block := range blocks {
+			cacher.schedule(types.MakeSigner(config, block.Number()), block.Transactions(), recovered[i])
+			recovered[i].Done()
+		}
+	}()
+	return recovered
 }
@@ -620,6 +620,16 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidUnitPrice
 	}
 
+	if pool.config.NoAccountCreation && tx.Type().IsAccountCreation() {
+		return ErrAccountCreationPrevented
+	}
+
+	// The transaction is executed in the next block, so the hard forks of the next block are applied.
+	next := new(big.Int).SetUint64(pool.currentBlockNumber + 1)
+	if err := tx.ValidateActivated(pool.chainconfig.Rules(next)); err != nil {
+		return err
+	}
+
 	// Heuristic limit, reject transactions over 32KB to prevent DOS attacks
 	if tx.Size() > MaxTxDataSize {
 		return ErrOversizedData
@@ -40,6 +40,8 @@ var (
 	errUndefinedAccountKeyType = errors.New("undefined account key type")
 	errWrongPubkeyLength       = errors.New("wrong pubkey length")
 	errInvalidSignature        = errors.New("invalid signature")
+	errNoSignatures            = errors.New("no signatures")
+	errInvalidRoleType         = errors.New("invalid role type")
 )
 
 var logger = log.NewModuleLogger(log.BlockchainTypesAccountKey)
@@ -123,6 +125,85 @@ func ValidateAccountKey(from common.Address, accKey AccountKey, pubkeys []*ecdsa
 	return nil
 }
 
+// RecoverPubkeys recovers the public keys which made the signatures of the message hash.
+// Each signature should be 65 bytes in the [R || S || V] format, where V is 0 or 1.
+// V of 27 or 28 is also accepted for the signatures made by klay_sign.
+func RecoverPubkeys(msgHash common.Hash, sigs [][]byte) ([]*ecdsa.PublicKey, error) {
+	if len(sigs) == 0 {
+		return nil, errNoSignatures
+	}
+	pubkeys := make([]*ecdsa.PublicKey, len(sigs))
+	for i, sig := range sigs {
+		if len(sig) != common.SignatureLength {
+			return nil, errInvalidSignature
+		}
+		if sig[common.SignatureLength-1] >= 27 {
+			sig = common.CopyBytes(sig)
+			sig[common.SignatureLength-1] -= 27
+		}
+		pubkey, err := crypto.SigToPub(msgHash[:], sig)
+		if err != nil {
+			return nil, err
+		}
+		pubkeys[i] = pubkey
+	}
+	return pubkeys, nil
+}
+
+// ValidateSignatures returns true if the signatures of the message hash are valid for the given role
+// of the account key of the address. The signatures are validated in the same way as the signatures
+// of a transaction: the weighted sum should reach the threshold of AccountKeyWeightedMultiSig,
+// the key of RoleTransaction is used if the role is not set in AccountKeyRoleBased, and
+// AccountKeyLegacy accepts only a signature made by the key derived from the address.
+// It returns an error if the role or the signatures are malformed.
+func ValidateSignatures(from common.Address, accKey AccountKey, msgHash common.Hash, sigs [][]byte, roleType RoleType) (bool, error) {
+	if roleType < RoleTransaction || roleType >= RoleLast {
+		return false, errInvalidRoleType
+	}
+	pubkeys, err := RecoverPubkeys(msgHash, sigs)
+	if err != nil {
+		return false, err
+	}
+	return ValidateAccountKey(from, accKey, pubkeys, roleType) == nil, nil
+}
+
+// NumKeysOfRole returns the number of public keys which can validate the signatures of the given role.
+// AccountKeyFail has no key, and AccountKeyLegacy has a key derived from the address.
+func NumKeysOfRole(accKey AccountKey, roleType RoleType) int {
+	switch key := accKey.(type) {
+	case *AccountKeyRoleBased:
+		if len(*key) > int(roleType) {
+			return NumKeysOfRole((*key)[roleType], roleType)
+		}
+		return NumKeysOfRole(key.getDefaultKey(), roleType)
+	case *AccountKeyWeightedMultiSig:
+		return len(key.Keys)
+	case *AccountKeyFail:
+		return 0
+	}
+	return 1
+}
+
+// WeightOfSignatures returns the weighted sum of the keys of the role which made the given signatures,
+// and the threshold the sum should reach to validate the signatures. An account key which is not
+// AccountKeyWeightedMultiSig has the threshold 1, and the sum is 1 only if the signatures are valid.
+func WeightOfSignatures(from common.Address, accKey AccountKey, pubkeys []*ecdsa.PublicKey, roleType RoleType) (uint, uint) {
+	switch key := accKey.(type) {
+	case *AccountKeyRoleBased:
+		if len(*key) > int(roleType) {
+			return WeightOfSignatures(from, (*key)[roleType], pubkeys, roleType)
+		}
+		return WeightOfSignatures(from, key.getDefaultKey(), pubkeys, roleType)
+	case *AccountKeyWeightedMultiSig:
+		return key.weightedSum(pubkeys), key.Threshold
+	}
+
+	if ValidateAccountKey(from, accKey, pubkeys, roleType) != nil {
+		return 0, 1
+	}
+	return 1, 1
+}
+
 // CheckReplacable returns nil if newKey can replace oldKey. The function checks updatability of newKey regardless of the newKey type.
 func CheckReplacable(oldKey AccountKey, newKey AccountKey, currentBlockNumber uint64) error {
 	if oldKey.Type() == newKey.Type() {
@@ -17,7 +17,9 @@
 package accountkey
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/ser/rlp"
 	"testing"
@@ -105,6 +107,90 @@ func testAccountKeyJSON(t *testing.T, k AccountKey) {
 	}
 }
 
+// TestValidateSignatures checks that signatures of a message are validated against
+// each type of account keys in the same way as the signatures of a transaction.
+func TestValidateSignatures(t *testing.T) {
+	var keys []*ecdsa.PrivateKey
+	for i := 0; i < 3; i++ {
+		k, _ := crypto.GenerateKey()
+		keys = append(keys, k)
+	}
+	from := crypto.PubkeyToAddress(keys[0].PublicKey)
+	msgHash := crypto.Keccak256Hash([]byte("klaytn"))
+
+	sign := func(ks ...*ecdsa.PrivateKey) [][]byte {
+		sigs := make([][]byte, len(ks))
+		for i, k := range ks {
+			sigs[i], _ = crypto.Sign(msgHash[:], k)
+		}
+		return sigs
+	}
+
+	multisig := NewAccountKeyWeightedMultiSigWithValues(2, WeightedPublicKeys{
+		NewWeightedPublicKey(1, (*PublicKeySerializable)(&keys[0].PublicKey)),
+		NewWeightedPublicKey(1, (*PublicKeySerializable)(&keys[1].PublicKey)),
+		NewWeightedPublicKey(2, (*PublicKeySerializable)(&keys[2].PublicKey)),
+	})
+	roleBased := NewAccountKeyRoleBasedWithValues([]AccountKey{
+		NewAccountKeyPublicWithValue(&keys[1].PublicKey),
+		multisig,
+	})
+
+	// Signatures made by klay_sign have V of 27 or 28.
+	sigV27 := sign(keys[0])
+	sigV27[0][common.SignatureLength-1] += 27
+
+	var testcases = []struct {
+		name     string
+		key      AccountKey
+		sigs     [][]byte
+		role     RoleType
+		expected bool
+	}{
+		{"legacy", NewAccountKeyLegacy(), sign(keys[0]), RoleTransaction, true},
+		{"legacy with V of 27", NewAccountKeyLegacy(), sigV27, RoleTransaction, true},
+		{"legacy with another key", NewAccountKeyLegacy(), sign(keys[1]), RoleTransaction, false},
+		{"legacy with two signatures", NewAccountKeyLegacy(), sign(keys[0], keys[1]), RoleTransaction, false},
+		{"public", NewAccountKeyPublicWithValue(&keys[1].PublicKey), sign(keys[1]), RoleFeePayer, true},
+		{"public with another key", NewAccountKeyPublicWithValue(&keys[1].PublicKey), sign(keys[0]), RoleTransaction, false},
+		{"fail", NewAccountKeyFail(), sign(keys[0]), RoleTransaction, false},
+		{"multisig over threshold", multisig, sign(keys[2]), RoleTransaction, true},
+		{"multisig under threshold", multisig, sign(keys[0]), RoleTransaction, false},
+		{"multisig with the same key twice", multisig, sign(keys[0], keys[0]), RoleTransaction, false},
+		{"multisig with two keys", multisig, sign(keys[0], keys[1]), RoleTransaction, true},
+		{"role-based transaction", roleBased, sign(keys[1]), RoleTransaction, true},
+		{"role-based account update", roleBased, sign(keys[0], keys[1]), RoleAccountUpdate, true},
+		{"role-based account update with transaction key", roleBased, sign(keys[1]), RoleAccountUpdate, false},
+		{"role-based fee payer falls back to transaction", roleBased, sign(keys[1]), RoleFeePayer, true},
+	}
+	for _, tc := range testcases {
+		valid, err := ValidateSignatures(from, tc.key, msgHash, tc.sigs, tc.role)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+		if valid != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, valid)
+		}
+	}
+
+	var errcases = []struct {
+		name string
+		sigs [][]byte
+		role RoleType
+		err  error
+	}{
+		{"no signatures", nil, RoleTransaction, errNoSignatures},
+		{"short signature", [][]byte{sign(keys[0])[0][:64]}, RoleTransaction, errInvalidSignature},
+		{"unknown role", sign(keys[0]), RoleLast, errInvalidRoleType},
+		{"negative role", sign(keys[0]), -1, errInvalidRoleType},
+	}
+	for _, tc := range errcases {
+		if _, err := ValidateSignatures(from, NewAccountKeyLegacy(), msgHash, tc.sigs, tc.role); err != tc.err {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
 func genAccountKeyNil() AccountKey {
 	return NewAccountKeyNil()
 }
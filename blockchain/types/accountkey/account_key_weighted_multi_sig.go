@@ -74,6 +74,20 @@ func (a *AccountKeyWeightedMultiSig) Equal(b AccountKey) bool {
 }
 
 func (a *AccountKeyWeightedMultiSig) Validate(r RoleType, pubkeys []*ecdsa.PublicKey) bool {
+	weightedSum := a.weightedSum(pubkeys)
+
+	if weightedSum >= a.Threshold {
+		return true
+	}
+
+	logger.Debug("AccountKeyWeightedMultiSig validation is failed", "pubkeys", pubkeys,
+		"accountKeys", a.String(), "threshold", a.Threshold, "weighted sum", weightedSum)
+
+	return false
+}
+
+// weightedSum returns the sum of the weights of the keys in the given public keys.
+func (a *AccountKeyWeightedMultiSig) weightedSum(pubkeys []*ecdsa.PublicKey) uint {
 	weightedSum := uint(0)
 
 	// To prohibit making a signature with the same key, make a map.
@@ -100,14 +114,7 @@ func (a *AccountKeyWeightedMultiSig) Validate(r RoleType, pubkeys []*ecdsa.Publi
 		}
 	}
 
-	if weightedSum >= a.Threshold {
-		return true
-	}
-
-	logger.Debug("AccountKeyWeightedMultiSig validation is failed", "pubkeys", pubkeys,
-		"accountKeys", a.String(), "threshold", a.Threshold, "weighted sum", weightedSum)
-
-	return false
+	return weightedSum
 }
 
 func (a *AccountKeyWeightedMultiSig) String() string {
@@ -17,15 +17,31 @@
 package types
 
 import (
+	"errors"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/ser/rlp"
 	"math/big"
 )
 
 const (
 	AnchoringDataType0 uint8 = 0
+	AnchoringDataType1 uint8 = 1
 )
 
+var (
+	errUnknownAnchoringDataType = errors.New("unknown anchoring data type")
+	errEmptyAnchoringBlocks     = errors.New("no block to anchor")
+)
+
+// AnchoringDataInternal is the decoded anchoring data of a chain data anchoring transaction.
+type AnchoringDataInternal interface {
+	// GetBlockHash returns the hash of the last anchored block.
+	GetBlockHash() common.Hash
+	// GetBlockNumber returns the number of the last anchored block.
+	GetBlockNumber() *big.Int
+}
+
 type AnchoringData struct {
 	Type uint8
 	Data []byte
@@ -52,6 +68,24 @@ type AnchoringDataInternalType0 struct {
 	TxCount       *big.Int
 }
 
+// AnchoringDataInternalType1 anchors a range of blocks in one anchoring transaction
+// with the merkle root over the block hashes of the range.
+type AnchoringDataInternalType1 struct {
+	BlockHash        common.Hash // the hash of the last block of the range.
+	BlockNumber      *big.Int    // the number of the last block of the range.
+	StartBlockNumber *big.Int
+	BlockCount       *big.Int
+	BlocksRoot       common.Hash // the merkle root made by BlockHashesRoot.
+	TxCount          *big.Int
+}
+
+func (data *AnchoringDataLegacy) GetBlockHash() common.Hash        { return data.BlockHash }
+func (data *AnchoringDataLegacy) GetBlockNumber() *big.Int         { return data.BlockNumber }
+func (data *AnchoringDataInternalType0) GetBlockHash() common.Hash { return data.BlockHash }
+func (data *AnchoringDataInternalType0) GetBlockNumber() *big.Int  { return data.BlockNumber }
+func (data *AnchoringDataInternalType1) GetBlockHash() common.Hash { return data.BlockHash }
+func (data *AnchoringDataInternalType1) GetBlockNumber() *big.Int  { return data.BlockNumber }
+
 func NewAnchoringDataType0(block *Block, period *big.Int, txCount *big.Int) (*AnchoringData, error) {
 	data := &AnchoringDataInternalType0{block.Hash(), block.Header().TxHash,
 		block.Header().ParentHash, block.Header().ReceiptHash,
@@ -62,3 +96,116 @@ func NewAnchoringDataType0(block *Block, period *big.Int, txCount *big.Int) (*An
 	}
 	return &AnchoringData{AnchoringDataType0, encodedCCTxData}, nil
 }
+
+// NewAnchoringDataType1 returns the anchoring data of the given consecutive headers in ascending order.
+func NewAnchoringDataType1(headers []*Header, txCount *big.Int) (*AnchoringData, error) {
+	if len(headers) == 0 {
+		return nil, errEmptyAnchoringBlocks
+	}
+	hashes := make([]common.Hash, len(headers))
+	for i, header := range headers {
+		hashes[i] = header.Hash()
+	}
+	last := headers[len(headers)-1]
+	data := &AnchoringDataInternalType1{last.Hash(), last.Number, headers[0].Number,
+		big.NewInt(int64(len(headers))), BlockHashesRoot(hashes), txCount}
+	encodedCCTxData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	return &AnchoringData{AnchoringDataType1, encodedCCTxData}, nil
+}
+
+// DecodeAnchoringData decodes the anchoring data of a chain data anchoring transaction.
+// It returns *AnchoringDataLegacy, *AnchoringDataInternalType0 or *AnchoringDataInternalType1.
+func DecodeAnchoringData(data []byte) (AnchoringDataInternal, error) {
+	anchoringData := new(AnchoringData)
+	if err := rlp.DecodeBytes(data, anchoringData); err != nil {
+		// Try to decode old type without a type support for compatibility.
+		anchoringDataLegacy := new(AnchoringDataLegacy)
+		if err := rlp.DecodeBytes(data, anchoringDataLegacy); err != nil {
+			return nil, err
+		}
+		return anchoringDataLegacy, nil
+	}
+
+	var internal AnchoringDataInternal
+	switch anchoringData.Type {
+	case AnchoringDataType0:
+		internal = new(AnchoringDataInternalType0)
+	case AnchoringDataType1:
+		internal = new(AnchoringDataInternalType1)
+	default:
+		return nil, errUnknownAnchoringDataType
+	}
+	if err := rlp.DecodeBytes(anchoringData.Data, internal); err != nil {
+		return nil, err
+	}
+	return internal, nil
+}
+
+// BlockHashesRoot returns the merkle root over the given block hashes.
+// Each pair of nodes is hashed by keccak256 and the last node of an odd level is paired with itself.
+func BlockHashesRoot(hashes []common.Hash) common.Hash {
+	if len(hashes) == 0 {
+		return common.Hash{}
+	}
+	level := append([]common.Hash{}, hashes...)
+	for len(level) > 1 {
+		level = nextBlockHashesLevel(level)
+	}
+	return level[0]
+}
+
+// BlockHashesProof returns the sibling nodes from the bottom to prove the index-th block hash
+// of the given block hashes against BlockHashesRoot.
+func BlockHashesProof(hashes []common.Hash, index int) []common.Hash {
+	if index < 0 || index >= len(hashes) {
+		return nil
+	}
+	proof := []common.Hash{}
+	level := append([]common.Hash{}, hashes...)
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		proof = append(proof, level[index^1])
+		level = nextBlockHashesLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyBlockHashesProof checks if the given block hash is the index-th of count block hashes
+// whose BlockHashesRoot is root, by using the proof made by BlockHashesProof.
+func VerifyBlockHashesProof(root, hash common.Hash, index, count int, proof []common.Hash) bool {
+	if index < 0 || index >= count {
+		return false
+	}
+	i := 0
+	for ; count > 1; i++ {
+		if i >= len(proof) {
+			return false
+		}
+		if index%2 == 0 {
+			hash = crypto.Keccak256Hash(hash[:], proof[i][:])
+		} else {
+			hash = crypto.Keccak256Hash(proof[i][:], hash[:])
+		}
+		index /= 2
+		count = (count + 1) / 2
+	}
+	return i == len(proof) && hash == root
+}
+
+// nextBlockHashesLevel returns the parent level of the given level of the block hashes merkle tree.
+func nextBlockHashesLevel(level []common.Hash) []common.Hash {
+	if len(level)%2 == 1 {
+		level = append(level, level[len(level)-1])
+	}
+	next := make([]common.Hash, len(level)/2)
+	for i := range next {
+		next[i] = crypto.Keccak256Hash(level[2*i][:], level[2*i+1][:])
+	}
+	return next
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestBlockHashesProof(t *testing.T) {
+	for count := 1; count <= 17; count++ {
+		hashes := make([]common.Hash, count)
+		for i := range hashes {
+			hashes[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+		}
+		root := BlockHashesRoot(hashes)
+
+		for i, hash := range hashes {
+			proof := BlockHashesProof(hashes, i)
+			assert.True(t, VerifyBlockHashesProof(root, hash, i, count, proof), "count %d, index %d", count, i)
+
+			// The proof should fail with a wrong hash, index or count.
+			assert.False(t, VerifyBlockHashesProof(root, common.Hash{}, i, count, proof))
+			if count > 1 {
+				assert.False(t, VerifyBlockHashesProof(root, hash, (i+1)%count, count, proof))
+			}
+			assert.False(t, VerifyBlockHashesProof(root, hash, i, count*2+1, proof))
+		}
+		assert.Nil(t, BlockHashesProof(hashes, count))
+	}
+}
+
+func TestDecodeAnchoringData(t *testing.T) {
+	var headers []*Header
+	parentHash := common.Hash{}
+	for i := int64(1); i <= 4; i++ {
+		header := &Header{ParentHash: parentHash, Number: big.NewInt(i), BlockScore: big.NewInt(1), Time: big.NewInt(i)}
+		headers = append(headers, header)
+		parentHash = header.Hash()
+	}
+	last := headers[len(headers)-1]
+
+	// Legacy
+	legacy := &AnchoringDataLegacy{BlockHash: last.Hash(), BlockNumber: last.Number}
+	encoded, err := rlp.EncodeToBytes(legacy)
+	assert.NoError(t, err)
+	decoded, err := DecodeAnchoringData(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, last.Hash(), decoded.GetBlockHash())
+	assert.IsType(t, &AnchoringDataLegacy{}, decoded)
+
+	// Type 0
+	anchoringData, err := NewAnchoringDataType0(NewBlockWithHeader(last), big.NewInt(1), big.NewInt(0))
+	assert.NoError(t, err)
+	encoded, err = rlp.EncodeToBytes(anchoringData)
+	assert.NoError(t, err)
+	decoded, err = DecodeAnchoringData(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, last.Hash(), decoded.GetBlockHash())
+	assert.IsType(t, &AnchoringDataInternalType0{}, decoded)
+
+	// Type 1
+	anchoringData, err = NewAnchoringDataType1(headers, big.NewInt(10))
+	assert.NoError(t, err)
+	encoded, err = rlp.EncodeToBytes(anchoringData)
+	assert.NoError(t, err)
+	decoded, err = DecodeAnchoringData(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, last.Hash(), decoded.GetBlockHash())
+	assert.Equal(t, last.Number, decoded.GetBlockNumber())
+	if batch, ok := decoded.(*AnchoringDataInternalType1); assert.True(t, ok) {
+		assert.Equal(t, big.NewInt(1), batch.StartBlockNumber)
+		assert.Equal(t, big.NewInt(4), batch.BlockCount)
+		assert.Equal(t, big.NewInt(10), batch.TxCount)
+		assert.True(t, VerifyBlockHashesProof(batch.BlocksRoot, headers[2].Hash(), 2, 4, BlockHashesProof([]common.Hash{
+			headers[0].Hash(), headers[1].Hash(), headers[2].Hash(), headers[3].Hash()}, 2)))
+	}
+
+	// Unknown type
+	encoded, err = rlp.EncodeToBytes(&AnchoringData{Type: 2})
+	assert.NoError(t, err)
+	_, err = DecodeAnchoringData(encoded)
+	assert.Equal(t, errUnknownAnchoringDataType, err)
+
+	_, err = NewAnchoringDataType1(nil, big.NewInt(0))
+	assert.Equal(t, errEmptyAnchoringBlocks, err)
+}
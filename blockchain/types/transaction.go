@@ -29,6 +29,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/ser/rlp"
 	"io"
 	"math/big"
@@ -44,6 +45,7 @@ var (
 	errLegacyTransaction              = errors.New("should not be called by a legacy transaction")
 	errNotImplementTxInternalDataFrom = errors.New("not implement TxInternalDataFrom")
 	errNotFeePayer                    = errors.New("not implement fee payer interface")
+	ErrTxTypeNotActivated             = errors.New("transaction type is not activated yet")
 )
 
 // deriveSigner makes a *best* guess about which signer to use.
@@ -183,6 +185,20 @@ func (tx *Transaction) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
+// DecodeUnsignedTx decodes the RLP encoding of a transaction without validating the signatures.
+// It is used to deliver a transaction to be signed, whose signatures are not set yet.
+func DecodeUnsignedTx(b []byte) (*Transaction, error) {
+	serializer := newTxInternalDataSerializer()
+	if err := rlp.DecodeBytes(b, serializer); err != nil {
+		return nil, err
+	}
+
+	tx := &Transaction{data: serializer.tx}
+	tx.Size()
+
+	return tx, nil
+}
+
 // MarshalJSON encodes the web3 RPC transaction format.
 func (tx *Transaction) MarshalJSON() ([]byte, error) {
 	hash := tx.Hash()
@@ -226,6 +242,15 @@ func (tx *Transaction) Validate(db StateDB, blockNumber uint64) error {
 	return tx.data.Validate(db, blockNumber)
 }
 
+// ValidateActivated checks if the transaction type is activated by the hard forks of the given rules.
+// Transactions are decoded regardless of the block, so this should be checked before Validate.
+func (tx *Transaction) ValidateActivated(rules params.Rules) error {
+	if tx.Type().IsAccountCreation() && !rules.IsHumanReadable {
+		return ErrTxTypeNotActivated
+	}
+	return nil
+}
+
 // ValidateMutableValue conducts validation of the sender's account key and additional validation for each transaction type.
 func (tx *Transaction) ValidateMutableValue(db StateDB, signer Signer, currentBlockNumber uint64) error {
 	// validate the sender's account key
@@ -336,6 +361,18 @@ func (tx *Transaction) FeeRatio() (FeeRatio, bool) {
 	return tf.GetFeeRatio(), ok
 }
 
+// AccountKey returns the account key set by a transaction and a boolean value indicating
+// TxInternalDataAccountKey implementation. The account creation and account update
+// transactions implement TxInternalDataAccountKey.
+func (tx *Transaction) AccountKey() (accountkey.AccountKey, bool) {
+	tk, ok := tx.data.(TxInternalDataAccountKey)
+	if !ok {
+		return nil, ok
+	}
+
+	return tk.GetAccountKey(), ok
+}
+
 // Hash hashes the RLP encoding of tx.
 // It uniquely identifies the transaction.
 func (tx *Transaction) Hash() common.Hash {
@@ -519,6 +556,16 @@ func (tx *Transaction) RawSignatureValues() TxSignatures {
 	return tx.data.RawSignatureValues()
 }
 
+// RawFeePayerSignatureValues returns the fee payer signatures of a fee-delegated transaction.
+// It returns nil if the transaction is not a fee-delegated transaction.
+func (tx *Transaction) RawFeePayerSignatureValues() TxSignatures {
+	tf, ok := tx.data.(TxInternalDataFeePayer)
+	if !ok {
+		return nil
+	}
+	return tf.GetFeePayerRawSignatureValues()
+}
+
 func (tx *Transaction) String() string {
 	return tx.data.String()
 }
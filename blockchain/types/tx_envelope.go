@@ -0,0 +1,229 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"math/big"
+)
+
+var (
+	ErrLegacyTxEnvelope    = errors.New("a legacy transaction cannot be put in an envelope")
+	ErrNoChainIdInEnvelope = errors.New("the chain ID of the envelope is not set")
+)
+
+// TxEnvelope is a portable form of a transaction collecting the signatures of several keys,
+// such as the keys of an account with AccountKeyWeightedMultiSig. The signatures of the sender
+// and the fee payer are collected separately from the transaction, and the signed transaction
+// is assembled by Transaction when they are enough.
+type TxEnvelope struct {
+	Tx                 hexutil.Bytes    `json:"tx"` // RLP encoding of the transaction without signatures
+	ChainID            *hexutil.Big     `json:"chainId"`
+	Signatures         TxSignaturesJSON `json:"signatures"`
+	FeePayerSignatures TxSignaturesJSON `json:"feePayerSignatures"`
+}
+
+// NewTxEnvelope puts the transaction in a new envelope. The signatures of the transaction,
+// if any, are collected in the envelope.
+func NewTxEnvelope(tx *Transaction, chainID *big.Int) (*TxEnvelope, error) {
+	if chainID == nil {
+		return nil, ErrNoChainIdInEnvelope
+	}
+	if tx.IsLegacyTransaction() {
+		return nil, ErrLegacyTxEnvelope
+	}
+
+	encoded, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	unsigned, err := DecodeUnsignedTx(encoded)
+	if err != nil {
+		return nil, err
+	}
+	unsigned.SetSignature(NewTxSignatures())
+	if tx.IsFeeDelegatedTransaction() {
+		if err := unsigned.SetFeePayerSignatures(NewTxSignatures()); err != nil {
+			return nil, err
+		}
+	}
+	if encoded, err = rlp.EncodeToBytes(unsigned); err != nil {
+		return nil, err
+	}
+
+	env := &TxEnvelope{
+		Tx:                 encoded,
+		ChainID:            (*hexutil.Big)(new(big.Int).Set(chainID)),
+		Signatures:         TxSignaturesJSON{},
+		FeePayerSignatures: TxSignaturesJSON{},
+	}
+	if err := env.AddSignatures(tx.RawSignatureValues()); err != nil {
+		return nil, err
+	}
+	if err := env.AddFeePayerSignatures(tx.RawFeePayerSignatureValues()); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// Transaction returns the transaction with the signatures collected in the envelope.
+func (env *TxEnvelope) Transaction() (*Transaction, error) {
+	if env.ChainID == nil {
+		return nil, ErrNoChainIdInEnvelope
+	}
+	tx, err := DecodeUnsignedTx(env.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if tx.IsLegacyTransaction() {
+		return nil, ErrLegacyTxEnvelope
+	}
+	if len(env.Signatures) > 0 {
+		tx.SetSignature(env.Signatures.ToTxSignatures())
+	}
+	if len(env.FeePayerSignatures) > 0 {
+		if err := tx.SetFeePayerSignatures(env.FeePayerSignatures.ToTxSignatures()); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// AddSignatures adds the sender signatures to the envelope. Empty signatures and the ones
+// already in the envelope are ignored.
+func (env *TxEnvelope) AddSignatures(sigs TxSignatures) error {
+	merged, err := env.mergeSignatures(env.Signatures, sigs)
+	if err != nil {
+		return err
+	}
+	env.Signatures = merged
+	return nil
+}
+
+// AddFeePayerSignatures adds the fee payer signatures to the envelope. Empty signatures and
+// the ones already in the envelope are ignored.
+func (env *TxEnvelope) AddFeePayerSignatures(sigs TxSignatures) error {
+	merged, err := env.mergeSignatures(env.FeePayerSignatures, sigs)
+	if err != nil {
+		return err
+	}
+	env.FeePayerSignatures = merged
+	return nil
+}
+
+// Sign adds the sender signature of the given private key to the envelope.
+func (env *TxEnvelope) Sign(prv *ecdsa.PrivateKey) error {
+	tx, err := env.Transaction()
+	if err != nil {
+		return err
+	}
+	signer := NewEIP155Signer(env.ChainID.ToInt())
+	sig, err := NewTxSignatureWithValues(signer, signer.Hash(tx), prv)
+	if err != nil {
+		return err
+	}
+	return env.AddSignatures(TxSignatures{sig})
+}
+
+// SignAsFeePayer adds the fee payer signature of the given private key to the envelope.
+func (env *TxEnvelope) SignAsFeePayer(prv *ecdsa.PrivateKey) error {
+	tx, err := env.Transaction()
+	if err != nil {
+		return err
+	}
+	signer := NewEIP155Signer(env.ChainID.ToInt())
+	hash, err := signer.HashFeePayer(tx)
+	if err != nil {
+		return err
+	}
+	sig, err := NewTxSignatureWithValues(signer, hash, prv)
+	if err != nil {
+		return err
+	}
+	return env.AddFeePayerSignatures(TxSignatures{sig})
+}
+
+// SenderPubkeys returns the public keys recovered from the sender signatures in the envelope.
+func (env *TxEnvelope) SenderPubkeys() ([]*ecdsa.PublicKey, error) {
+	tx, err := env.Transaction()
+	if err != nil {
+		return nil, err
+	}
+	return env.recoverPubkeys(env.Signatures, NewEIP155Signer(env.ChainID.ToInt()).Hash(tx))
+}
+
+// FeePayerPubkeys returns the public keys recovered from the fee payer signatures in the envelope.
+func (env *TxEnvelope) FeePayerPubkeys() ([]*ecdsa.PublicKey, error) {
+	tx, err := env.Transaction()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := NewEIP155Signer(env.ChainID.ToInt()).HashFeePayer(tx)
+	if err != nil {
+		return nil, err
+	}
+	return env.recoverPubkeys(env.FeePayerSignatures, hash)
+}
+
+func (env *TxEnvelope) recoverPubkeys(sigs TxSignaturesJSON, hash common.Hash) ([]*ecdsa.PublicKey, error) {
+	if len(sigs) == 0 {
+		return nil, nil
+	}
+	chainIdMul := new(big.Int).Mul(env.ChainID.ToInt(), big.NewInt(2))
+	return sigs.ToTxSignatures().RecoverPubkey(hash, true, func(v *big.Int) *big.Int {
+		V := new(big.Int).Sub(v, chainIdMul)
+		return V.Sub(V, big8)
+	})
+}
+
+func (env *TxEnvelope) mergeSignatures(collected TxSignaturesJSON, sigs TxSignatures) (TxSignaturesJSON, error) {
+	if env.ChainID == nil {
+		return nil, ErrNoChainIdInEnvelope
+	}
+	merged := collected.ToTxSignatures()
+	for _, sig := range sigs {
+		if sig == nil || sig.R == nil || sig.R.Sign() == 0 {
+			continue
+		}
+		if !sig.ValidateSignature() {
+			return nil, ErrInvalidSig
+		}
+		if sig.ChainId().Cmp(env.ChainID.ToInt()) != 0 {
+			return nil, ErrInvalidChainId
+		}
+		duplicated := false
+		for _, s := range merged {
+			if s.equal(sig) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			merged = append(merged, sig)
+		}
+	}
+	if uint64(len(merged)) > accountkey.MaxNumKeysForMultiSig {
+		return nil, kerrors.ErrMaxKeysExceed
+	}
+	return merged.ToJSON(), nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// TestTxEnvelopeMultiSig collects the signatures of a 2-of-3 multisig sender and a fee payer
+// in an envelope passed around in JSON, and checks the assembled transaction.
+func TestTxEnvelopeMultiSig(t *testing.T) {
+	chainID := big.NewInt(1000)
+	signer := NewEIP155Signer(chainID)
+
+	var (
+		keys     []*ecdsa.PrivateKey
+		wpkeys   accountkey.WeightedPublicKeys
+		from     = common.HexToAddress("0x1")
+		feePrv   *ecdsa.PrivateKey
+		feePayer common.Address
+	)
+	for i := 0; i < 3; i++ {
+		k, _ := crypto.GenerateKey()
+		keys = append(keys, k)
+		wpkeys = append(wpkeys, accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&k.PublicKey)))
+	}
+	accKey := accountkey.NewAccountKeyWeightedMultiSigWithValues(2, wpkeys)
+	feePrv, _ = crypto.GenerateKey()
+	feePayer = crypto.PubkeyToAddress(feePrv.PublicKey)
+
+	tx, err := NewTransactionWithMap(TxTypeFeeDelegatedValueTransfer, map[TxValueKeyType]interface{}{
+		TxValueKeyNonce:    uint64(0),
+		TxValueKeyFrom:     from,
+		TxValueKeyTo:       common.HexToAddress("0x2"),
+		TxValueKeyAmount:   big.NewInt(1),
+		TxValueKeyGasLimit: uint64(100000),
+		TxValueKeyGasPrice: big.NewInt(25),
+		TxValueKeyFeePayer: feePayer,
+	})
+	assert.NoError(t, err)
+
+	env, err := NewTxEnvelope(tx, chainID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(env.Signatures))
+
+	// Each signer decodes the envelope, signs it and passes it to the next one.
+	pass := func(env *TxEnvelope) *TxEnvelope {
+		b, err := json.Marshal(env)
+		assert.NoError(t, err)
+		decoded := new(TxEnvelope)
+		assert.NoError(t, json.Unmarshal(b, decoded))
+		return decoded
+	}
+
+	env = pass(env)
+	assert.NoError(t, env.Sign(keys[0]))
+	assert.NoError(t, env.Sign(keys[0])) // a duplicated signature is ignored
+	pubkeys, err := env.SenderPubkeys()
+	assert.NoError(t, err)
+	weight, threshold := accountkey.WeightOfSignatures(from, accKey, pubkeys, accountkey.RoleTransaction)
+	assert.Equal(t, uint(1), weight)
+	assert.Equal(t, uint(2), threshold)
+
+	env = pass(env)
+	assert.NoError(t, env.Sign(keys[2]))
+	assert.NoError(t, env.SignAsFeePayer(feePrv))
+	env = pass(env)
+
+	pubkeys, err = env.SenderPubkeys()
+	assert.NoError(t, err)
+	weight, threshold = accountkey.WeightOfSignatures(from, accKey, pubkeys, accountkey.RoleTransaction)
+	assert.Equal(t, uint(2), weight)
+	assert.Equal(t, uint(2), threshold)
+	assert.NoError(t, accountkey.ValidateAccountKey(from, accKey, pubkeys, accountkey.RoleTransaction))
+
+	// The assembled transaction carries the collected signatures.
+	signed, err := env.Transaction()
+	assert.NoError(t, err)
+	assert.Equal(t, signer.Hash(tx), signer.Hash(signed))
+	assert.Equal(t, 2, len(signed.RawSignatureValues()))
+	feePubkeys, err := signer.SenderFeePayer(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(feePubkeys))
+	assert.Equal(t, feePayer, crypto.PubkeyToAddress(*feePubkeys[0]))
+
+	// The envelope can be rebuilt from the partially signed transaction.
+	rebuilt, err := NewTxEnvelope(signed, chainID)
+	assert.NoError(t, err)
+	assert.Equal(t, env.Tx, rebuilt.Tx)
+	assert.Equal(t, 2, len(rebuilt.Signatures))
+	assert.Equal(t, 1, len(rebuilt.FeePayerSignatures))
+
+	// The signatures of another chain are rejected.
+	other, err := NewTxEnvelope(tx, big.NewInt(1001))
+	assert.NoError(t, err)
+	assert.NoError(t, other.Sign(keys[1]))
+	assert.Equal(t, ErrInvalidChainId, env.AddSignatures(other.Signatures.ToTxSignatures()))
+}
+
+func TestTxEnvelopeLegacy(t *testing.T) {
+	tx := NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(25), nil)
+	_, err := NewTxEnvelope(tx, big.NewInt(1))
+	assert.Equal(t, ErrLegacyTxEnvelope, err)
+
+	_, err = NewTxEnvelope(tx, nil)
+	assert.Equal(t, ErrNoChainIdInEnvelope, err)
+}
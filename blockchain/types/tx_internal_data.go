@@ -331,6 +331,13 @@ type TxInternalDataFrom interface {
 	GetFrom() common.Address
 }
 
+// TxInternalDataAccountKey has a function `GetAccountKey()`.
+// The transactions creating an account or updating the key of an account
+// implement this interface to provide the account key to be set.
+type TxInternalDataAccountKey interface {
+	GetAccountKey() accountkey.AccountKey
+}
+
 // TxInternalDataPayload has a function `GetPayload()`.
 // Since the payload field is not a common field for all tx types, we provide
 // an interface `TxInternalDataPayload` to obtain the payload.
@@ -350,6 +357,7 @@ type VM interface {
 // TODO-Klaytn-Refactoring: Transaction and related data structures should be a new package.
 type StateDB interface {
 	IncNonce(common.Address)
+	GetNonce(common.Address) uint64
 	Exist(common.Address) bool
 	UpdateKey(addr common.Address, key accountkey.AccountKey, currentBlockNumber uint64) error
 	CreateEOA(addr common.Address, humanReadable bool, key accountkey.AccountKey)
@@ -358,6 +366,7 @@ type StateDB interface {
 	IsProgramAccount(addr common.Address) bool
 	IsContractAvailable(addr common.Address) bool
 	IsValidCodeFormat(addr common.Address) bool
+	IsHumanReadable(addr common.Address) bool
 	GetKey(addr common.Address) accountkey.AccountKey
 }
 
@@ -377,8 +386,9 @@ func NewTxInternalData(t TxType) (TxInternalData, error) {
 		return newTxInternalDataFeeDelegatedValueTransferMemo(), nil
 	case TxTypeFeeDelegatedValueTransferMemoWithRatio:
 		return newTxInternalDataFeeDelegatedValueTransferMemoWithRatio(), nil
-	//case TxTypeAccountCreation:
-	//	return newTxInternalDataAccountCreation(), nil
+	case TxTypeAccountCreation:
+		// It is rejected by Transaction.ValidateActivated before the human-readable address fork.
+		return newTxInternalDataAccountCreation(), nil
 	case TxTypeAccountUpdate:
 		return newTxInternalDataAccountUpdate(), nil
 	case TxTypeFeeDelegatedAccountUpdate:
@@ -426,8 +436,8 @@ func NewTxInternalDataWithMap(t TxType, values map[TxValueKeyType]interface{}) (
 		return newTxInternalDataFeeDelegatedValueTransferMemoWithMap(values)
 	case TxTypeFeeDelegatedValueTransferMemoWithRatio:
 		return newTxInternalDataFeeDelegatedValueTransferMemoWithRatioWithMap(values)
-	//case TxTypeAccountCreation:
-	//	return newTxInternalDataAccountCreationWithMap(values)
+	case TxTypeAccountCreation:
+		return newTxInternalDataAccountCreationWithMap(values)
 	case TxTypeAccountUpdate:
 		return newTxInternalDataAccountUpdateWithMap(values)
 	case TxTypeFeeDelegatedAccountUpdate:
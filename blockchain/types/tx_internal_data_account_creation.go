@@ -349,6 +349,10 @@ func (t *TxInternalDataAccountCreation) GetFrom() common.Address {
 	return t.From
 }
 
+func (t *TxInternalDataAccountCreation) GetAccountKey() accountkey.AccountKey {
+	return t.Key
+}
+
 func (t *TxInternalDataAccountCreation) GetHash() *common.Hash {
 	return t.Hash
 }
@@ -446,28 +450,38 @@ func (t *TxInternalDataAccountCreation) SenderTxHash() common.Hash {
 	return h
 }
 
+// Validate checks the transaction regardless of the state. The account creation transaction is
+// only allowed to register a human-readable address, since the other accounts are created by
+// sending KLAY to them.
 func (t *TxInternalDataAccountCreation) Validate(stateDB StateDB, currentBlockNumber uint64) error {
-	return errUndefinedTxType
-	//if t.HumanReadable {
-	//	return kerrors.ErrHumanReadableNotSupported
-	//}
-	//if common.IsPrecompiledContractAddress(t.Recipient) {
-	//	return kerrors.ErrPrecompiledContractAddress
-	//}
-	//if err := t.Key.CheckInstallable(currentBlockNumber); err != nil {
-	//	return err
-	//}
-	//
-	//return t.ValidateMutableValue(stateDB, currentBlockNumber)
+	if !t.HumanReadable || !common.IsHumanReadableAddress(t.Recipient) {
+		return kerrors.ErrNotHumanReadableAddress
+	}
+	// Nobody can sign with the legacy key of a human-readable address.
+	if t.Key.Type().IsLegacyAccountKey() {
+		return kerrors.ErrLegacyKeyForHumanReadable
+	}
+	if err := t.Key.CheckInstallable(currentBlockNumber); err != nil {
+		return err
+	}
+
+	return t.ValidateMutableValue(stateDB, currentBlockNumber)
 }
 
 func (t *TxInternalDataAccountCreation) ValidateMutableValue(stateDB StateDB, currentBlockNumber uint64) error {
-	return errUndefinedTxType
-	//// Fail if the address is already created.
-	//if stateDB.Exist(t.Recipient) {
-	//	return kerrors.ErrAccountAlreadyExists
-	//}
-	//return nil
+	// Fail if the address is already created. Anyone can send KLAY to a human-readable address
+	// before it is registered, so an account which has never been used is not regarded as created.
+	if stateDB.Exist(t.Recipient) && !isUnusedAccount(stateDB, t.Recipient) {
+		return kerrors.ErrAccountAlreadyExists
+	}
+	return nil
+}
+
+// isUnusedAccount returns true if the account has only received KLAY. Such an account has
+// the legacy account key and no nonce, since nobody can sign with the key.
+func isUnusedAccount(stateDB StateDB, addr common.Address) bool {
+	return stateDB.GetNonce(addr) == 0 && !stateDB.IsProgramAccount(addr) && !stateDB.IsHumanReadable(addr) &&
+		stateDB.GetKey(addr).Type().IsLegacyAccountKey()
 }
 
 func (t *TxInternalDataAccountCreation) Execute(sender ContractRef, vm VM, stateDB StateDB, currentBlockNumber uint64, gas uint64, value *big.Int) (ret []byte, usedGas uint64, err error) {
@@ -246,6 +246,10 @@ func (t *TxInternalDataAccountUpdate) GetFrom() common.Address {
 	return t.From
 }
 
+func (t *TxInternalDataAccountUpdate) GetAccountKey() accountkey.AccountKey {
+	return t.Key
+}
+
 func (t *TxInternalDataAccountUpdate) GetHash() *common.Hash {
 	return t.Hash
 }
@@ -270,6 +270,10 @@ func (t *TxInternalDataFeeDelegatedAccountUpdate) GetFrom() common.Address {
 	return t.From
 }
 
+func (t *TxInternalDataFeeDelegatedAccountUpdate) GetAccountKey() accountkey.AccountKey {
+	return t.Key
+}
+
 func (t *TxInternalDataFeeDelegatedAccountUpdate) GetHash() *common.Hash {
 	return t.Hash
 }
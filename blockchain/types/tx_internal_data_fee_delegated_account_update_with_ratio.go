@@ -288,6 +288,10 @@ func (t *TxInternalDataFeeDelegatedAccountUpdateWithRatio) GetFrom() common.Addr
 	return t.From
 }
 
+func (t *TxInternalDataFeeDelegatedAccountUpdateWithRatio) GetAccountKey() accountkey.AccountKey {
+	return t.Key
+}
+
 func (t *TxInternalDataFeeDelegatedAccountUpdateWithRatio) GetHash() *common.Hash {
 	return t.Hash
 }
@@ -20,6 +20,13 @@
 
 package vm
 
+import (
+	"container/list"
+	"github.com/klaytn/klaytn/common"
+	"math"
+	"sync"
+)
+
 // bitvec is a bit vector which maps bytes in a program.
 // An unset bit means the byte is an opcode, a set bit means
 // it's data (i.e. argument of PUSHxx).
@@ -64,3 +71,128 @@ func codeBitmap(code []byte) bitvec {
 	}
 	return bits
 }
+
+// codeAnalysis is the result of the static analysis of a code with a jump table.
+type codeAnalysis struct {
+	// jumpdests is the result of JUMPDEST analysis.
+	jumpdests bitvec
+	// blockGas maps the start of each basic block to the sum of the constant gas
+	// of the operations in the block plus one. It is zero for the other positions.
+	blockGas []uint32
+}
+
+// size returns the approximate memory size of the analysis in bytes.
+func (a *codeAnalysis) size() int {
+	return len(a.jumpdests) + 4*len(a.blockGas)
+}
+
+// endsBlock returns true if the operation should be the last one of a basic block.
+// The constant gas of a basic block is charged at once at its start, so a block ends
+// at an operation which jumps, halts, reads the remaining gas or charges dynamic gas.
+// The last condition keeps the remaining gas seen by every dynamic gas function and
+// every error of the block the same as charging the gas operation by operation.
+func endsBlock(op OpCode, operation *operation) bool {
+	return !operation.valid || operation.jumps || operation.halts || operation.reverts ||
+		operation.dynamicGas != nil || op == GAS
+}
+
+// analyseCode returns the JUMPDEST analysis and the basic block gas table of the code.
+func analyseCode(code []byte, jumpTable *[256]operation) *codeAnalysis {
+	a := &codeAnalysis{
+		jumpdests: codeBitmap(code),
+		blockGas:  make([]uint32, len(code)),
+	}
+	var (
+		start    uint64 // start of the current basic block
+		gas      uint64 // constant gas of the current basic block
+		newBlock = true
+	)
+	for pc := uint64(0); pc < uint64(len(code)); pc++ {
+		if !a.jumpdests.codeSegment(pc) {
+			continue
+		}
+		op := OpCode(code[pc])
+		operation := &jumpTable[op]
+		if op == JUMPDEST || gas+operation.constantGas >= math.MaxUint32 {
+			newBlock = true
+		}
+		if newBlock {
+			start, gas, newBlock = pc, 0, false
+		}
+		gas += operation.constantGas
+		a.blockGas[start] = uint32(gas + 1)
+		newBlock = endsBlock(op, operation)
+	}
+	return a
+}
+
+// codeAnalysisCacheSize is the maximum memory size of codeAnalyses in bytes.
+const codeAnalysisCacheSize = 64 * 1024 * 1024
+
+// codeAnalyses is the cache of code analyses shared by all EVMs. Hot contracts are
+// analysed once instead of every transaction.
+var codeAnalyses = newCodeAnalysisCache(codeAnalysisCacheSize)
+
+type codeAnalysisKey struct {
+	codeHash  common.Hash
+	jumpTable *[256]operation
+}
+
+type codeAnalysisEntry struct {
+	key      codeAnalysisKey
+	analysis *codeAnalysis
+}
+
+// codeAnalysisCache is a LRU cache of code analyses bounded by their memory size.
+type codeAnalysisCache struct {
+	mu      sync.Mutex
+	maxSize int
+	size    int
+	items   map[codeAnalysisKey]*list.Element
+	order   *list.List // front is the most recently used
+}
+
+func newCodeAnalysisCache(maxSize int) *codeAnalysisCache {
+	return &codeAnalysisCache{
+		maxSize: maxSize,
+		items:   make(map[codeAnalysisKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the analysis of the code with the hash, analysing the code if it is not cached.
+func (c *codeAnalysisCache) get(codeHash common.Hash, code []byte, jumpTable *[256]operation) *codeAnalysis {
+	key := codeAnalysisKey{codeHash, jumpTable}
+
+	c.mu.Lock()
+	if elem, ok := c.items[key]; ok {
+		c.order.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*codeAnalysisEntry).analysis
+	}
+	c.mu.Unlock()
+
+	// Analyse the code without the lock, so that a large code does not block the others.
+	analysis := analyseCode(code, jumpTable)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[key]; ok || analysis.size() > c.maxSize {
+		return analysis
+	}
+	c.items[key] = c.order.PushFront(&codeAnalysisEntry{key, analysis})
+	c.size += analysis.size()
+	for c.size > c.maxSize {
+		oldest := c.order.Remove(c.order.Back()).(*codeAnalysisEntry)
+		delete(c.items, oldest.key)
+		c.size -= oldest.analysis.size()
+	}
+	return analysis
+}
+
+// len returns the number of cached analyses.
+func (c *codeAnalysisCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -23,6 +23,7 @@ package vm
 import (
 	"testing"
 
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 )
 
@@ -77,3 +78,68 @@ func BenchmarkJumpdestHashing_1200k(bench *testing.B) {
 	}
 	bench.StopTimer()
 }
+
+func TestBasicBlockGasAnalysis(t *testing.T) {
+	code := []byte{
+		byte(PUSH1), 0x01, byte(PUSH1), 0x02, byte(ADD), // block at 0: 3 + 3 + 3
+		byte(JUMPDEST), byte(GAS), // block at 5: 1 + 2, ends at GAS
+		byte(POP), byte(STOP), // block at 7: 2 + 0
+	}
+	expected := []uint32{10, 0, 0, 0, 0, 4, 0, 3, 0}
+
+	analysis := analyseCode(code, &IstanbulInstructionSet)
+	if len(analysis.blockGas) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(analysis.blockGas))
+	}
+	for pc, gas := range expected {
+		if analysis.blockGas[pc] != gas {
+			t.Errorf("pc %d: expected %d, got %d", pc, gas, analysis.blockGas[pc])
+		}
+	}
+}
+
+func TestCodeAnalysisCache(t *testing.T) {
+	code := make([]byte, 64)
+	size := analyseCode(code, &IstanbulInstructionSet).size()
+	cache := newCodeAnalysisCache(2 * size)
+
+	hashes := []common.Hash{{1}, {2}, {3}}
+	first := cache.get(hashes[0], code, &IstanbulInstructionSet)
+	if cache.get(hashes[0], code, &IstanbulInstructionSet) != first {
+		t.Fatal("expected the cached analysis")
+	}
+	// The analysis with another jump table is cached separately.
+	if cache.get(hashes[0], code, &ConstantinopleInstructionSet) == first {
+		t.Fatal("expected a new analysis for another jump table")
+	}
+	// The least recently used analysis is evicted.
+	cache.get(hashes[0], code, &IstanbulInstructionSet)
+	cache.get(hashes[1], code, &IstanbulInstructionSet)
+	if cache.len() != 2 {
+		t.Fatalf("expected 2 analyses, got %d", cache.len())
+	}
+	if cache.get(hashes[0], code, &IstanbulInstructionSet) != first {
+		t.Fatal("expected the cached analysis")
+	}
+	cache.get(hashes[2], code, &IstanbulInstructionSet)
+	if _, ok := cache.items[codeAnalysisKey{hashes[1], &IstanbulInstructionSet}]; ok {
+		t.Fatal("expected the least recently used analysis to be evicted")
+	}
+}
+
+func BenchmarkCodeAnalysis_24k(bench *testing.B) {
+	code := make([]byte, 24*1024)
+	bench.ResetTimer()
+	for i := 0; i < bench.N; i++ {
+		analyseCode(code, &IstanbulInstructionSet)
+	}
+}
+
+func BenchmarkCodeAnalysisCache_24k(bench *testing.B) {
+	code := make([]byte, 24*1024)
+	hash := crypto.Keccak256Hash(code)
+	bench.ResetTimer()
+	for i := 0; i < bench.N; i++ {
+		codeAnalyses.get(hash, code, &IstanbulInstructionSet)
+	}
+}
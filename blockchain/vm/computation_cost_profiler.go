@@ -0,0 +1,162 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"math/big"
+	"time"
+)
+
+// PrecompiledContractTracer is implemented by a Tracer which also wants to capture
+// the execution of precompiled contracts. The precompiled contracts are executed
+// without any opcode, so they are not captured by CaptureState.
+type PrecompiledContractTracer interface {
+	CapturePrecompiledContract(addr common.Address, gas, computationCost uint64, t time.Duration, err error) error
+}
+
+// ComputationCostProfile is the accounting of the executions of an opcode or a precompiled contract.
+type ComputationCostProfile struct {
+	Count           uint64        `json:"count"`
+	Gas             uint64        `json:"gas"`
+	ComputationCost uint64        `json:"computationCost"`
+	Time            time.Duration `json:"time,omitempty"`
+}
+
+func (p *ComputationCostProfile) add(gas, computationCost uint64, t time.Duration) {
+	p.Count++
+	p.Gas += gas
+	p.ComputationCost += computationCost
+	p.Time += t
+}
+
+// ComputationCostResult is the result of ComputationCostProfiler.
+type ComputationCostResult struct {
+	Gas                  uint64                                     `json:"gas"`
+	Failed               bool                                       `json:"failed"`
+	ComputationCost      uint64                                     `json:"computationCost"`
+	Opcodes              map[string]*ComputationCostProfile         `json:"opcodes"`
+	PrecompiledContracts map[common.Address]*ComputationCostProfile `json:"precompiledContracts"`
+}
+
+// ComputationCostProfiler is a Tracer which accounts the number of executions, the gas and
+// the computation cost of each opcode and precompiled contract. If measureTime is set, it also
+// measures the elapsed time of them, which can be compared with the computation cost table.
+//
+// The gas of a call-like opcode does not include the gas given to the callee.
+type ComputationCostProfiler struct {
+	opcodes     map[OpCode]*ComputationCostProfile
+	precompiled map[common.Address]*ComputationCostProfile
+
+	measureTime bool
+	lastOp      *ComputationCostProfile // the profile of the last captured opcode
+	lastTime    time.Time               // the time when the last opcode is captured
+}
+
+// NewComputationCostProfiler returns a new ComputationCostProfiler.
+func NewComputationCostProfiler(measureTime bool) *ComputationCostProfiler {
+	return &ComputationCostProfiler{
+		opcodes:     make(map[OpCode]*ComputationCostProfile),
+		precompiled: make(map[common.Address]*ComputationCostProfile),
+		measureTime: measureTime,
+	}
+}
+
+// CaptureStart implements the Tracer interface.
+func (p *ComputationCostProfiler) CaptureStart(env *EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+// CaptureState accounts an opcode right before it is executed.
+func (p *ComputationCostProfiler) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	p.flushTime()
+
+	// The gas of the opcode has already been charged from the contract.
+	used := gas - contract.Gas
+	switch op {
+	case CALL, CALLCODE, DELEGATECALL, STATICCALL:
+		used -= env.callGasTemp
+	}
+
+	profile, ok := p.opcodes[op]
+	if !ok {
+		profile = new(ComputationCostProfile)
+		p.opcodes[op] = profile
+	}
+	profile.add(used, env.interpreter.jumpTable[op].computationCost, 0)
+
+	p.lastOp = profile
+	if p.measureTime {
+		p.lastTime = time.Now()
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface.
+func (p *ComputationCostProfiler) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called when the top-level call is finished.
+func (p *ComputationCostProfiler) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	p.flushTime()
+	p.lastOp = nil
+	return nil
+}
+
+// CapturePrecompiledContract accounts the execution of a precompiled contract.
+func (p *ComputationCostProfiler) CapturePrecompiledContract(addr common.Address, gas, computationCost uint64, t time.Duration, err error) error {
+	profile, ok := p.precompiled[addr]
+	if !ok {
+		profile = new(ComputationCostProfile)
+		p.precompiled[addr] = profile
+	}
+	profile.add(gas, computationCost, t)
+
+	// Exclude the execution time of the precompiled contract from the calling opcode.
+	if p.measureTime && p.lastOp != nil {
+		p.lastTime = p.lastTime.Add(t)
+	}
+	return nil
+}
+
+// flushTime attributes the time elapsed from the last captured opcode to it.
+func (p *ComputationCostProfiler) flushTime() {
+	if p.measureTime && p.lastOp != nil {
+		p.lastOp.Time += time.Since(p.lastTime)
+	}
+}
+
+// Result returns the accounting of opcodes and precompiled contracts with the gas used by
+// the transaction or call and whether it failed.
+func (p *ComputationCostProfiler) Result(gas uint64, failed bool) *ComputationCostResult {
+	result := &ComputationCostResult{
+		Gas:                  gas,
+		Failed:               failed,
+		Opcodes:              make(map[string]*ComputationCostProfile, len(p.opcodes)),
+		PrecompiledContracts: make(map[common.Address]*ComputationCostProfile, len(p.precompiled)),
+	}
+	for op, profile := range p.opcodes {
+		result.Opcodes[op.String()] = profile
+		result.ComputationCost += profile.ComputationCost
+	}
+	for addr, profile := range p.precompiled {
+		result.PrecompiledContracts[addr] = profile
+		result.ComputationCost += profile.ComputationCost
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestComputationCostProfiler(t *testing.T) {
+	var (
+		address = common.BytesToAddress([]byte("contract"))
+		// PUSH1 0x20 PUSH1 0 PUSH1 0x20 PUSH1 0 PUSH1 0x04 GAS STATICCALL POP STOP
+		code = common.Hex2Bytes("60206000602060006004" + "5afa5000")
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	statedb.CreateSmartContractAccount(address, params.CodeFormatEVM)
+	statedb.SetCode(address, code)
+
+	profiler := NewComputationCostProfiler(true)
+	vmctx := Context{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	env := NewEVM(vmctx, statedb, params.TestChainConfig, &Config{Debug: true, Tracer: profiler})
+
+	gas := uint64(100000)
+	_, leftOverGas, err := env.Call(AccountRef(common.Address{}), address, nil, gas, new(big.Int))
+	assert.NoError(t, err)
+
+	result := profiler.Result(gas-leftOverGas, false)
+	expected := map[string]ComputationCostProfile{
+		"PUSH1":      {Count: 5, Gas: 5 * GasFastestStep, ComputationCost: 5 * params.PushComputationCost},
+		"GAS":        {Count: 1, Gas: GasQuickStep, ComputationCost: params.GasComputationCost},
+		"STATICCALL": {Count: 1, Gas: params.GasTableCypress.Calls + params.MemoryGas, ComputationCost: params.StaticCallComputationCost},
+		"POP":        {Count: 1, Gas: GasQuickStep, ComputationCost: params.PopComputationCost},
+		"STOP":       {Count: 1, Gas: 0, ComputationCost: params.StopComputationCost},
+	}
+	assert.Equal(t, len(expected), len(result.Opcodes))
+
+	var opcodeGas, computationCost uint64
+	for op, e := range expected {
+		if assert.Contains(t, result.Opcodes, op) {
+			p := result.Opcodes[op]
+			assert.Equal(t, e.Count, p.Count, op)
+			assert.Equal(t, e.Gas, p.Gas, op)
+			assert.Equal(t, e.ComputationCost, p.ComputationCost, op)
+		}
+		opcodeGas += e.Gas
+		computationCost += e.ComputationCost
+	}
+
+	identity := result.PrecompiledContracts[common.BytesToAddress([]byte{4})]
+	if assert.NotNil(t, identity) {
+		assert.Equal(t, uint64(1), identity.Count)
+		assert.Equal(t, params.IdentityBaseGas+params.IdentityPerWordGas, identity.Gas)
+		assert.Equal(t, uint64(params.IdentityBaseComputationCost+params.IdentityPerWordComputationCost), identity.ComputationCost)
+		computationCost += identity.ComputationCost
+	}
+
+	// The gas of all opcodes and precompiled contracts adds up to the gas used by the call.
+	assert.Equal(t, gas-leftOverGas, opcodeGas+identity.Gas)
+	assert.Equal(t, computationCost, result.ComputationCost)
+}
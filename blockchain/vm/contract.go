@@ -50,8 +50,7 @@ type Contract struct {
 	caller          types.ContractRef
 	self            types.ContractRef
 
-	jumpdests map[common.Hash]bitvec // Aggregated result of JUMPDEST analysis.
-	analysis  bitvec                 // Locally cached result of JUMPDEST analysis
+	analysis *codeAnalysis // Result of JUMPDEST and basic block gas analysis
 
 	Code     []byte
 	CodeHash common.Hash
@@ -66,13 +65,6 @@ type Contract struct {
 func NewContract(caller types.ContractRef, object types.ContractRef, value *big.Int, gas uint64) *Contract {
 	c := &Contract{CallerAddress: caller.Address(), FeePayerAddress: caller.FeePayer(), caller: caller, self: object}
 
-	if parent, ok := caller.(*Contract); ok {
-		// Reuse JUMPDEST analysis from parent context if available.
-		c.jumpdests = parent.jumpdests
-	} else {
-		c.jumpdests = make(map[common.Hash]bitvec)
-	}
-
 	// Gas should be a pointer so it can safely be reduced through the run
 	// This pointer will be off the state transition
 	c.Gas = gas
@@ -93,26 +85,12 @@ func (c *Contract) validJumpdest(dest *big.Int) bool {
 	if OpCode(c.Code[udest]) != JUMPDEST {
 		return false
 	}
-	// Do we have a contract hash already?
-	if c.CodeHash != (common.Hash{}) {
-		// Does parent context have the analysis?
-		analysis, exist := c.jumpdests[c.CodeHash]
-		if !exist {
-			// Do the analysis and save in parent context
-			// We do not need to store it in c.analysis
-			analysis = codeBitmap(c.Code)
-			c.jumpdests[c.CodeHash] = analysis
-		}
-		return analysis.codeSegment(udest)
-	}
-	// We don't have the code hash, most likely a piece of initcode not already
-	// in state trie. In that case, we do an analysis, and save it locally, so
-	// we don't have to recalculate it for every JUMP instruction in the execution
-	// However, we don't save it within the parent context
+	// The interpreter sets the analysis before the execution. Analyse the code here
+	// only if the contract is used without the interpreter.
 	if c.analysis == nil {
-		c.analysis = codeBitmap(c.Code)
+		c.analysis = &codeAnalysis{jumpdests: codeBitmap(c.Code)}
 	}
-	return c.analysis.codeSegment(udest)
+	return c.analysis.jumpdests.codeSegment(udest)
 }
 
 // AsDelegate sets the contract to be a delegate call and returns the current
@@ -178,12 +156,14 @@ func (c *Contract) SetCallCode(addr *common.Address, hash common.Hash, code []by
 	c.Code = code
 	c.CodeHash = hash
 	c.CodeAddr = addr
+	c.analysis = nil
 }
 
 // SetCodeOptionalHash can be used to provide code, but it's optional to provide hash.
-// In case hash is not provided, the jumpdest analysis will not be saved to the parent context
+// In case hash is not provided, the code analysis will not be saved to the shared cache
 func (c *Contract) SetCodeOptionalHash(addr *common.Address, codeAndHash *codeAndHash) {
 	c.Code = codeAndHash.code
 	c.CodeHash = codeAndHash.hash
 	c.CodeAddr = addr
+	c.analysis = nil
 }
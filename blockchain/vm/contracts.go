@@ -23,6 +23,7 @@ package vm
 import (
 	"crypto/ecdsa"
 	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"github.com/klaytn/klaytn/api/debug"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -30,6 +31,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/crypto/blake2b"
 	"github.com/klaytn/klaytn/crypto/bn256"
 	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/log"
@@ -44,6 +46,11 @@ var logger = log.NewModuleLogger(log.VM)
 var (
 	errInputTooShort        = errors.New("input length is too short")
 	errWrongSignatureLength = errors.New("wrong signature length")
+	errTooManySignatures    = errors.New("the number of signatures exceeds the number of keys")
+	errInvalidRoleType      = errors.New("invalid role type")
+
+	errBlake2FInvalidInputLength = errors.New("invalid input length")
+	errBlake2FInvalidFinalFlag   = errors.New("invalid final flag")
 )
 
 // PrecompiledContract is the basic interface for native Go contracts. The implementation
@@ -67,6 +74,13 @@ var feePayerAddress = common.BytesToAddress([]byte{10})
 // validateSenderAddress is the address of precompiled contract ValidateSender.
 var validateSenderAddress = common.BytesToAddress([]byte{11})
 
+// blake2FAddress is the address of precompiled contract blake2F.
+// It is placed after the Klaytn-specific precompiled contracts since 0x09 is already taken by vmLog.
+var blake2FAddress = common.BytesToAddress([]byte{12})
+
+// validateAccountKeyAddress is the address of precompiled contract validateAccountKey.
+var validateAccountKeyAddress = common.BytesToAddress([]byte{13})
+
 // PrecompiledContractsCypress contains the default set of pre-compiled contracts.
 var PrecompiledContractsCypress = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{1}): &ecrecover{},
@@ -82,6 +96,32 @@ var PrecompiledContractsCypress = map[common.Address]PrecompiledContract{
 	validateSenderAddress:            &precompiledValidateSender{},
 }
 
+// PrecompiledContractsIstanbul contains the set of pre-compiled contracts
+// available after the Istanbul fork.
+var PrecompiledContractsIstanbul = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{1}): &ecrecover{},
+	common.BytesToAddress([]byte{2}): &sha256hash{},
+	common.BytesToAddress([]byte{3}): &ripemd160hash{},
+	common.BytesToAddress([]byte{4}): &dataCopy{},
+	common.BytesToAddress([]byte{5}): &bigModExp{},
+	common.BytesToAddress([]byte{6}): &bn256Add{},
+	common.BytesToAddress([]byte{7}): &bn256ScalarMul{},
+	common.BytesToAddress([]byte{8}): &bn256Pairing{},
+	vmLogAddress:                     &vmLog{},
+	feePayerAddress:                  &feePayer{},
+	validateSenderAddress:            &precompiledValidateSender{},
+	blake2FAddress:                   &blake2F{},
+	validateAccountKeyAddress:        &validateAccountKey{},
+}
+
+// ActivePrecompiles returns the set of precompiled contracts enabled with the given rules.
+func ActivePrecompiles(rules params.Rules) map[common.Address]PrecompiledContract {
+	if rules.IsIstanbul {
+		return PrecompiledContractsIstanbul
+	}
+	return PrecompiledContractsCypress
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, computationCost uint64, err error) {
 	gas, computationCost := p.GetRequiredGasAndComputationCost(input)
@@ -400,6 +440,67 @@ func (c *bn256Pairing) Run(input []byte) ([]byte, error) {
 	return false32Byte, nil
 }
 
+// blake2F implements the BLAKE2b F compression function (EIP-152) as a native contract.
+type blake2F struct{}
+
+const (
+	blake2FInputLength        = 213
+	blake2FFinalBlockBytes    = byte(1)
+	blake2FNonFinalBlockBytes = byte(0)
+)
+
+// GetRequiredGasAndComputationCost returns the gas required to execute the pre-compiled contract
+// and the computation cost of the precompiled contract.
+func (c *blake2F) GetRequiredGasAndComputationCost(input []byte) (uint64, uint64) {
+	// If the input is malformed, we can't calculate the gas, return 0 and let the
+	// actual call choke and fault.
+	if len(input) != blake2FInputLength {
+		return 0, params.Blake2bFBaseComputationCost
+	}
+	rounds := uint64(binary.BigEndian.Uint32(input[0:4]))
+	return rounds * params.Blake2bFRoundGas,
+		params.Blake2bFBaseComputationCost + rounds*params.Blake2bFRoundComputationCost
+}
+
+func (c *blake2F) Run(input []byte) ([]byte, error) {
+	// Make sure the input is valid (correct length and final flag)
+	if len(input) != blake2FInputLength {
+		return nil, errBlake2FInvalidInputLength
+	}
+	if input[212] != blake2FNonFinalBlockBytes && input[212] != blake2FFinalBlockBytes {
+		return nil, errBlake2FInvalidFinalFlag
+	}
+	// Parse the input into the Blake2b call parameters
+	var (
+		rounds = binary.BigEndian.Uint32(input[0:4])
+		final  = input[212] == blake2FFinalBlockBytes
+
+		h [8]uint64
+		m [16]uint64
+		t [2]uint64
+	)
+	for i := 0; i < 8; i++ {
+		offset := 4 + i*8
+		h[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	for i := 0; i < 16; i++ {
+		offset := 68 + i*8
+		m[i] = binary.LittleEndian.Uint64(input[offset : offset+8])
+	}
+	t[0] = binary.LittleEndian.Uint64(input[196:204])
+	t[1] = binary.LittleEndian.Uint64(input[204:212])
+
+	// Execute the compression function, extract and return the result
+	blake2b.F(&h, m, t, final, rounds)
+
+	output := make([]byte, 64)
+	for i := 0; i < 8; i++ {
+		offset := i * 8
+		binary.LittleEndian.PutUint64(output[offset:offset+8], h[i])
+	}
+	return output, nil
+}
+
 // vmLog implemented as a native contract.
 type vmLog struct{}
 
@@ -529,3 +630,82 @@ func validateSender(input []byte, picker types.AccountKeyPicker) error {
 
 	return nil
 }
+
+// validateAccountKey implemented as a native contract.
+// It validates signatures of a message hash with the account key of an address for the given role.
+type validateAccountKey struct{}
+
+// GetRequiredGasAndComputationCost returns the base gas and computation cost of the precompiled contract.
+// The costs depending on the number of keys are charged in RunValidateAccountKeyContract,
+// since the account key should be read from the state.
+func (c *validateAccountKey) GetRequiredGasAndComputationCost(input []byte) (uint64, uint64) {
+	return params.ValidateAccountKeyBaseGas, params.ValidateAccountKeyBaseComputationCost
+}
+
+func (c *validateAccountKey) Run(input []byte) ([]byte, error) {
+	// Run function should not be called. Instead of this function, RunValidateAccountKeyContract should be called.
+	logger.Error("should not be reached here")
+	return nil, nil
+}
+
+// RunValidateAccountKeyContract returns true32Byte if the signatures satisfy the account key of the address
+// for the role. The input is (address, role, message hash, signature1, signature2, and more signatures),
+// which are 20 bytes, 1 byte, 32 bytes and 65 bytes for each signature.
+// The gas and computation cost are proportional to the number of keys used for the role.
+func RunValidateAccountKeyContract(p PrecompiledContract, input []byte, contract *Contract, picker types.AccountKeyPicker) ([]byte, uint64, error) {
+	gas, computationCost := p.GetRequiredGasAndComputationCost(input)
+	if contract.UseGas(gas) == false {
+		return nil, computationCost, kerrors.ErrOutOfGas
+	}
+
+	from, role, msgHash, sigs, err := parseValidateAccountKeyInput(input)
+	if err != nil {
+		// If return error makes contract execution failed, do not return the error.
+		// Instead, print log.
+		logger.Trace("validateAccountKey failed", "err", err)
+		return false32Byte, computationCost, nil
+	}
+
+	accKey := picker.GetKey(from)
+	numKeys := uint64(accountkey.NumKeysOfRole(accKey, role))
+	computationCost += numKeys * params.ValidateAccountKeyPerKeyComputationCost
+	if contract.UseGas(numKeys*params.ValidateAccountKeyPerKeyGas) == false {
+		return nil, computationCost, kerrors.ErrOutOfGas
+	}
+
+	// Each signature should be made by a distinct key, so the number of signatures to be
+	// recovered is bounded by the number of keys paid for.
+	if uint64(len(sigs)) > numKeys {
+		logger.Trace("validateAccountKey failed", "err", errTooManySignatures)
+		return false32Byte, computationCost, nil
+	}
+	valid, err := accountkey.ValidateSignatures(from, accKey, msgHash, sigs, role)
+	if err != nil || !valid {
+		logger.Trace("validateAccountKey failed", "err", err)
+		return false32Byte, computationCost, nil
+	}
+	return true32Byte, computationCost, nil
+}
+
+func parseValidateAccountKeyInput(input []byte) (common.Address, accountkey.RoleType, common.Hash, [][]byte, error) {
+	const headerLength = common.AddressLength + 1 + common.HashLength
+	if len(input) < headerLength {
+		return common.Address{}, 0, common.Hash{}, nil, errInputTooShort
+	}
+	from := common.BytesToAddress(input[0:common.AddressLength])
+	role := accountkey.RoleType(input[common.AddressLength])
+	if role >= accountkey.RoleLast {
+		return common.Address{}, 0, common.Hash{}, nil, errInvalidRoleType
+	}
+	msgHash := common.BytesToHash(input[common.AddressLength+1 : headerLength])
+
+	ptr := input[headerLength:]
+	if len(ptr)%common.SignatureLength != 0 {
+		return common.Address{}, 0, common.Hash{}, nil, errWrongSignatureLength
+	}
+	sigs := make([][]byte, len(ptr)/common.SignatureLength)
+	for i := range sigs {
+		sigs[i] = ptr[i*common.SignatureLength : (i+1)*common.SignatureLength]
+	}
+	return from, role, msgHash, sigs, nil
+}
@@ -21,10 +21,12 @@
 package vm
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/kerrors"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -406,8 +408,19 @@ var validateSenderTest = []precompiledTest{
 	},
 }
 
+// blake2FTests are the test and benchmark data for the blake2F precompiled contract.
+// The input compresses the single block "abc" with the BLAKE2b-512 initial state,
+// so the output is the BLAKE2b-512 digest of "abc" (EIP-152 test vector 5).
+var blake2FTests = []precompiledTest{
+	{
+		input:    "0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b61626300000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000001",
+		expected: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+		name:     "vector 5",
+	},
+}
+
 func testPrecompiled(addr string, test precompiledTest, t *testing.T) {
-	p := PrecompiledContractsCypress[common.HexToAddress(addr)]
+	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 	contract := NewContract(AccountRef(common.HexToAddress("1337")),
@@ -425,7 +438,7 @@ func benchmarkPrecompiled(addr string, test precompiledTest, bench *testing.B) {
 	if test.noBenchmark {
 		return
 	}
-	p := PrecompiledContractsCypress[common.HexToAddress(addr)]
+	p := PrecompiledContractsIstanbul[common.HexToAddress(addr)]
 	in := common.Hex2Bytes(test.input)
 	reqGas, _ := p.GetRequiredGasAndComputationCost(in)
 	contract := NewContract(AccountRef(common.HexToAddress("1337")),
@@ -550,6 +563,39 @@ func BenchmarkPrecompiledBn256Pairing(bench *testing.B) {
 	}
 }
 
+// Tests the sample inputs from the BLAKE2b F compression function EIP 152.
+func TestPrecompiledBlake2F(t *testing.T) {
+	for _, test := range blake2FTests {
+		testPrecompiled("0C", test, t)
+	}
+
+	p := PrecompiledContractsIstanbul[common.HexToAddress("0C")]
+	in := common.Hex2Bytes(blake2FTests[0].input)
+	if gas, _ := p.GetRequiredGasAndComputationCost(in); gas != 12 {
+		t.Errorf("Expected gas 12, got %d", gas)
+	}
+	if _, err := p.Run(in[:len(in)-1]); err != errBlake2FInvalidInputLength {
+		t.Errorf("Expected %v, got %v", errBlake2FInvalidInputLength, err)
+	}
+	invalidFlag := common.CopyBytes(in)
+	invalidFlag[len(invalidFlag)-1] = 2
+	if _, err := p.Run(invalidFlag); err != errBlake2FInvalidFinalFlag {
+		t.Errorf("Expected %v, got %v", errBlake2FInvalidFinalFlag, err)
+	}
+
+	// blake2F is not available before the Istanbul fork.
+	if _, ok := PrecompiledContractsCypress[common.HexToAddress("0C")]; ok {
+		t.Error("blake2F should not be in the Cypress precompiled contracts")
+	}
+}
+
+// Benchmarks the sample inputs from the BLAKE2b F compression function EIP 152.
+func BenchmarkPrecompiledBlake2F(bench *testing.B) {
+	for _, test := range blake2FTests {
+		benchmarkPrecompiled("0C", test, bench)
+	}
+}
+
 func BenchmarkPrecompiledVmLog(b *testing.B) {
 	// Only stdout logging is tested to avoid file handling.
 	params.VMLogTarget = params.VMLogToStdout
@@ -748,3 +794,75 @@ func TestRunValidateSenderContract(t *testing.T) {
 		})
 	}
 }
+
+func TestRunValidateAccountKeyContract(t *testing.T) {
+	p := PrecompiledContractsIstanbul[common.HexToAddress("0D")]
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	for i := range keys {
+		var err error
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+	}
+	// RoleTransaction: 2-of-3 multisig with keys[0..2], RoleAccountUpdate: keys[3], RoleFeePayer: not set.
+	multiSig := accountkey.NewAccountKeyWeightedMultiSigWithValues(2, accountkey.WeightedPublicKeys{
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&keys[0].PublicKey)),
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&keys[1].PublicKey)),
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&keys[2].PublicKey)),
+	})
+	addr := common.HexToAddress("0x123456789")
+	statedb.CreateEOA(addr, false, accountkey.NewAccountKeyRoleBasedWithValues([]accountkey.AccountKey{
+		multiSig,
+		accountkey.NewAccountKeyPublicWithValue(&keys[3].PublicKey),
+	}))
+
+	msgHash := crypto.Keccak256Hash([]byte("message"))
+	input := func(role accountkey.RoleType, signers ...*ecdsa.PrivateKey) []byte {
+		in := append(addr.Bytes(), byte(role))
+		in = append(in, msgHash.Bytes()...)
+		for _, k := range signers {
+			sig, err := crypto.Sign(msgHash.Bytes(), k)
+			require.NoError(t, err)
+			in = append(in, sig...)
+		}
+		return in
+	}
+
+	tests := []struct {
+		name     string
+		input    []byte
+		numKeys  uint64
+		expected []byte
+	}{
+		{"threshold satisfied", input(accountkey.RoleTransaction, keys[0], keys[2]), 3, true32Byte},
+		{"threshold not satisfied", input(accountkey.RoleTransaction, keys[1]), 3, false32Byte},
+		{"unknown signer", input(accountkey.RoleTransaction, keys[0], keys[3]), 3, false32Byte},
+		{"too many signatures", input(accountkey.RoleTransaction, keys[0], keys[1], keys[2], keys[0]), 3, false32Byte},
+		{"role key", input(accountkey.RoleAccountUpdate, keys[3]), 1, true32Byte},
+		{"wrong role key", input(accountkey.RoleAccountUpdate, keys[0], keys[1]), 1, false32Byte},
+		{"default role key", input(accountkey.RoleFeePayer, keys[1], keys[2]), 3, true32Byte},
+		{"invalid role", input(accountkey.RoleLast, keys[3]), 0, false32Byte},
+		{"malformed signature", input(accountkey.RoleAccountUpdate, keys[3])[:100], 0, false32Byte},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gas := params.ValidateAccountKeyBaseGas + test.numKeys*params.ValidateAccountKeyPerKeyGas
+			contract := NewContract(AccountRef(common.HexToAddress("1337")), nil, new(big.Int), gas)
+			res, computationCost, err := RunValidateAccountKeyContract(p, test.input, contract, statedb)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+			require.Equal(t, uint64(0), contract.Gas)
+			require.Equal(t, params.ValidateAccountKeyBaseComputationCost+test.numKeys*params.ValidateAccountKeyPerKeyComputationCost, computationCost)
+		})
+	}
+
+	// The gas depending on the number of keys should be paid.
+	contract := NewContract(AccountRef(common.HexToAddress("1337")), nil, new(big.Int), params.ValidateAccountKeyBaseGas+2*params.ValidateAccountKeyPerKeyGas)
+	_, _, err := RunValidateAccountKeyContract(p, input(accountkey.RoleTransaction, keys[0], keys[1]), contract, statedb)
+	require.Equal(t, kerrors.ErrOutOfGas, err)
+
+	// validateAccountKey is not available before the Istanbul fork.
+	_, ok := PrecompiledContractsCypress[common.HexToAddress("0D")]
+	require.False(t, ok)
+}
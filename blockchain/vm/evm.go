@@ -54,15 +54,15 @@ type (
 // isProgramAccount returns true if the address is one of the following:
 // - an address of precompiled contracts
 // - an address of program accounts
-func isProgramAccount(addr common.Address, db StateDB) bool {
-	_, exists := PrecompiledContractsCypress[addr]
-	return exists || db.IsProgramAccount(addr)
+func isProgramAccount(evm *EVM, addr common.Address) bool {
+	_, exists := evm.precompiles()[addr]
+	return exists || evm.StateDB.IsProgramAccount(addr)
 }
 
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		precompiles := PrecompiledContractsCypress
+		precompiles := evm.precompiles()
 		if p := precompiles[*contract.CodeAddr]; p != nil {
 			var (
 				ret             []byte
@@ -76,6 +76,13 @@ func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 			//	startTime = time.Now()
 			//}
 			///////////////////////////////////////////////////////
+			var (
+				gasBefore uint64
+				startTime time.Time
+			)
+			if evm.vmConfig.Debug {
+				gasBefore, startTime = contract.Gas, time.Now()
+			}
 			switch *contract.CodeAddr {
 			case vmLogAddress:
 				ret, computationCost, err = RunVMLogContract(p, input, contract, evm)
@@ -83,6 +90,8 @@ func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 				ret, computationCost, err = RunFeePayerContract(p, input, contract)
 			case validateSenderAddress:
 				ret, computationCost, err = RunValidateSenderContract(p, input, contract, evm.StateDB)
+			case validateAccountKeyAddress:
+				ret, computationCost, err = RunValidateAccountKeyContract(p, input, contract, evm.StateDB)
 			default:
 				ret, computationCost, err = RunPrecompiledContract(p, input, contract) // TODO-Klaytn-Issue615
 			}
@@ -96,6 +105,11 @@ func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 			//	precompiledTime[addr] += elapsedTime
 			//}
 			///////////////////////////////////////////////////////
+			if evm.vmConfig.Debug {
+				if tracer, ok := evm.vmConfig.Tracer.(PrecompiledContractTracer); ok {
+					tracer.CapturePrecompiledContract(*contract.CodeAddr, gasBefore-contract.Gas, computationCost, time.Since(startTime), err)
+				}
+			}
 			evm.opcodeComputationCostSum += computationCost
 			return ret, err
 		}
@@ -184,6 +198,11 @@ func NewEVM(ctx Context, statedb StateDB, chainConfig *params.ChainConfig, vmCon
 	return evm
 }
 
+// precompiles returns the set of precompiled contracts enabled at the current block.
+func (evm *EVM) precompiles() map[common.Address]PrecompiledContract {
+	return ActivePrecompiles(evm.chainRules)
+}
+
 // Cancel cancels any running EVM operation. This may be called concurrently and
 // it's safe to be called multiple times.
 func (evm *EVM) Cancel(reason int32) {
@@ -221,11 +240,11 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 
 	// Filter out invalid precompiled address calls, and create a precompiled contract object if it is not exist.
 	if common.IsPrecompiledContractAddress(addr) {
-		precompiles := PrecompiledContractsCypress
+		precompiles := evm.precompiles()
 		if precompiles[addr] == nil || value.Sign() != 0 {
 			// Return an error if an enabled precompiled address is called or a value is transferred to a precompiled address.
 			if evm.vmConfig.Debug && evm.depth == 0 {
-				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+				evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 				evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
 			}
 			return nil, gas, kerrors.ErrPrecompiledContractAddress
@@ -242,7 +261,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 		if value.Sign() == 0 {
 			// Calling a non-existing account (probably contract), don't do antything, but ping the tracer
 			if evm.vmConfig.Debug && evm.depth == 0 {
-				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+				evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 				evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
 			}
 			return nil, gas, nil
@@ -252,7 +271,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 	}
 	evm.Transfer(evm.StateDB, caller.Address(), to.Address(), value)
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr) {
 		return ret, gas, nil
 	}
 
@@ -265,7 +284,7 @@ func (evm *EVM) Call(caller types.ContractRef, addr common.Address, input []byte
 
 	// Capture the tracer start/end events in debug mode
 	if evm.vmConfig.Debug && evm.depth == 0 {
-		evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
+		evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), addr, false, input, gas, value)
 
 		defer func() { // Lazy evaluation of the parameters
 			evm.vmConfig.Tracer.CaptureEnd(ret, gas-contract.Gas, time.Since(start), err)
@@ -306,7 +325,7 @@ func (evm *EVM) CallCode(caller types.ContractRef, addr common.Address, input []
 		return nil, gas, ErrInsufficientBalance // TODO-Klaytn-Issue615
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
@@ -344,7 +363,7 @@ func (evm *EVM) DelegateCall(caller types.ContractRef, addr common.Address, inpu
 		return nil, gas, ErrDepth // TODO-Klaytn-Issue615
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
@@ -388,7 +407,7 @@ func (evm *EVM) StaticCall(caller types.ContractRef, addr common.Address, input
 		defer func() { evm.interpreter.readOnly = false }()
 	}
 
-	if !isProgramAccount(addr, evm.StateDB) {
+	if !isProgramAccount(evm, addr) {
 		logger.Info("Returning since the addr is not a program account", "addr", addr)
 		return nil, gas, nil
 	}
@@ -469,7 +488,7 @@ func (evm *EVM) create(caller types.ContractRef, codeAndHash *codeAndHash, gas u
 	}
 
 	if evm.vmConfig.Debug && evm.depth == 0 {
-		evm.vmConfig.Tracer.CaptureStart(caller.Address(), address, true, codeAndHash.code, gas, value)
+		evm.vmConfig.Tracer.CaptureStart(evm, caller.Address(), address, true, codeAndHash.code, gas, value)
 	}
 	start := time.Now()
 
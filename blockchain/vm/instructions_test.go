@@ -1373,3 +1373,52 @@ func fillStacks(stacks []string, n int) []string {
 	}
 	return stacks
 }
+
+// TestIstanbulOpcodes checks that CHAINID and SELFBALANCE are only available after the Istanbul fork.
+func TestIstanbulOpcodes(t *testing.T) {
+	var (
+		address = common.BytesToAddress([]byte("contract"))
+		balance = big.NewInt(1234)
+		chainID = big.NewInt(1001)
+		// CHAINID PUSH1 0 SSTORE SELFBALANCE PUSH1 1 SSTORE
+		code = common.Hex2Bytes("4660005547600155")
+	)
+	forkedConfig := &params.ChainConfig{ChainID: chainID, IstanbulCompatibleBlock: big.NewInt(10)}
+
+	for _, tt := range []struct {
+		blockNumber int64
+		valid       bool
+	}{
+		{9, false},
+		{10, true},
+	} {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+		statedb.CreateSmartContractAccount(address, params.CodeFormatEVM)
+		statedb.SetCode(address, code)
+		statedb.AddBalance(address, balance)
+
+		vmctx := Context{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(tt.blockNumber),
+		}
+		env := NewEVM(vmctx, statedb, forkedConfig, &Config{})
+
+		_, _, err := env.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("block %d: expected an invalid opcode error", tt.blockNumber)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("block %d: unexpected error: %v", tt.blockNumber, err)
+		}
+		if got := statedb.GetState(address, common.BigToHash(big.NewInt(0))).Big(); got.Cmp(chainID) != 0 {
+			t.Errorf("block %d: chain id mismatch: have %v, want %v", tt.blockNumber, got, chainID)
+		}
+		if got := statedb.GetState(address, common.BigToHash(big.NewInt(1))).Big(); got.Cmp(balance) != 0 {
+			t.Errorf("block %d: self balance mismatch: have %v, want %v", tt.blockNumber, got, balance)
+		}
+	}
+}
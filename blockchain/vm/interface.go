@@ -49,8 +49,10 @@ type StateDB interface {
 	GetCodeSize(common.Address) int
 
 	AddRefund(uint64)
+	SubRefund(uint64)
 	GetRefund() uint64
 
+	GetCommittedState(common.Address, common.Hash) common.Hash
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
 
@@ -77,6 +79,7 @@ type StateDB interface {
 	IsProgramAccount(address common.Address) bool
 	IsContractAvailable(address common.Address) bool
 	IsValidCodeFormat(addr common.Address) bool
+	IsHumanReadable(addr common.Address) bool
 
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool)
 
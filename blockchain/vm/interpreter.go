@@ -51,6 +51,10 @@ type Config struct {
 
 	// UseOpcodeComputationCost is to enable applying the opcode computation cost limit.
 	UseOpcodeComputationCost bool
+
+	// NoCodeAnalysisCache disables the code analysis cache shared by EVMs and
+	// charging the constant gas of a basic block at once.
+	NoCodeAnalysisCache bool
 }
 
 // keccakState wraps sha3.state. In addition to the usual hash methods, it also supports
@@ -66,9 +70,12 @@ type keccakState interface {
 // The Interpreter will run the byte code VM based on the passed
 // configuration.
 type Interpreter struct {
-	evm      *EVM
-	cfg      *Config
-	gasTable params.GasTable
+	evm       *EVM
+	cfg       *Config
+	gasTable  params.GasTable
+	jumpTable *[256]operation
+
+	sharedAnalysis bool // Whether the code analyses are shared by EVMs with the default jump table
 
 	intPool *intPool
 
@@ -83,15 +90,25 @@ type Interpreter struct {
 func NewInterpreter(evm *EVM, cfg *Config) *Interpreter {
 	// We use the STOP instruction whether to see
 	// the jump table was initialised. If it was not
-	// we'll set the default jump table.
-	if !cfg.JumpTable[STOP].valid {
-		cfg.JumpTable = ConstantinopleInstructionSet
+	// we'll set the default jump table of the current fork.
+	jumpTable := &cfg.JumpTable
+	sharedAnalysis := false
+	if !jumpTable[STOP].valid {
+		sharedAnalysis = !cfg.NoCodeAnalysisCache
+		if evm.chainRules.IsIstanbul {
+			jumpTable = &IstanbulInstructionSet
+		} else {
+			jumpTable = &ConstantinopleInstructionSet
+		}
 	}
 
 	return &Interpreter{
-		evm:      evm,
-		cfg:      cfg,
-		gasTable: evm.ChainConfig().GasTable(evm.BlockNumber),
+		evm:       evm,
+		cfg:       cfg,
+		gasTable:  evm.ChainConfig().GasTable(evm.BlockNumber),
+		jumpTable: jumpTable,
+
+		sharedAnalysis: sharedAnalysis,
 	}
 }
 
@@ -135,7 +152,7 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 	//		defer func() {
 	//			for i := 0; i < 256; i++ {
 	//				if opCnt[i] > 0 {
-	//					fmt.Println("op", OpCode(i).String(), "computationCost", in.cfg.JumpTable[i].computationCost, "cnt", opCnt[i], "avg", opTime[i]/opCnt[i])
+	//					fmt.Println("op", OpCode(i).String(), "computationCost", in.jumpTable[i].computationCost, "cnt", opCnt[i], "avg", opTime[i]/opCnt[i])
 	//				}
 	//			}
 	//			for i := 0; i < 16; i++ {
@@ -160,6 +177,7 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 	if len(contract.Code) == 0 {
 		return nil, nil
 	}
+	in.analyse(contract)
 
 	var (
 		op    OpCode        // current opcode
@@ -170,6 +188,10 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 		// to be uint256. Practically much less so feasible.
 		pc   = uint64(0) // program counter
 		cost uint64
+		// blockGas is the basic block gas table, and prepaid is whether the constant gas
+		// of the current operation is charged at the start of its basic block.
+		blockGas []uint32
+		prepaid  bool
 		// copies used by tracer
 		pcCopy              uint64              // needed for the deferred Tracer
 		gasCopy             uint64              // for Tracer to log gas remaining before execution
@@ -179,6 +201,11 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 	)
 	contract.Input = input
 
+	// The tracer sees the gas charged operation by operation.
+	if !in.cfg.Debug && !in.cfg.NoCodeAnalysisCache {
+		blockGas = contract.analysis.blockGas
+	}
+
 	// Reclaim the stack as an int pool when the execution stops
 	defer func() { in.intPool.put(stack.data...) }()
 
@@ -213,7 +240,7 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 		// Get the operation from the jump table and validate the stack to ensure there are
 		// enough stack items available to perform the operation.
 		op = contract.GetOp(pc)
-		operation := in.cfg.JumpTable[op]
+		operation := in.jumpTable[op]
 		if !operation.valid {
 			return nil, fmt.Errorf("invalid opcode 0x%x", int(op)) // TODO-Klaytn-Issue615
 		}
@@ -236,7 +263,12 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 		}
 
 		// Static portion of gas
-		if !contract.UseGas(operation.constantGas) {
+		// If the gas is enough for the whole basic block, charge it at once. Otherwise,
+		// charge it operation by operation to return the same error as usual.
+		if pc < uint64(len(blockGas)) && blockGas[pc] > 0 {
+			prepaid = contract.UseGas(uint64(blockGas[pc] - 1))
+		}
+		if !prepaid && !contract.UseGas(operation.constantGas) {
 			return nil, kerrors.ErrOutOfGas
 		}
 
@@ -246,7 +278,7 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 			// OpcodeComputationCostLimit: The below code is commented and will be usd for debugging purposes.
 			//if opDebug && prevOp > 0 {
 			//	elapsed := uint64(time.Since(globalTimer).Nanoseconds())
-			//	fmt.Println("[", in.evm.depth, "]", "prevop", prevOp.String(), "-", op.String(),  "computationCost", in.cfg.JumpTable[prevOp].computationCost, "total", in.evm.opcodeComputationCostSum, "elapsed", elapsed)
+			//	fmt.Println("[", in.evm.depth, "]", "prevop", prevOp.String(), "-", op.String(),  "computationCost", in.jumpTable[prevOp].computationCost, "total", in.evm.opcodeComputationCostSum, "elapsed", elapsed)
 			//	opTime[prevOp] += elapsed
 			//	opCnt[prevOp] += 1
 			//}
@@ -327,3 +359,16 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 	}
 	return nil, nil
 }
+
+// analyse sets the code analysis of the contract. The analysis of a code with its hash
+// is shared by EVMs if the interpreter uses a default jump table.
+func (in *Interpreter) analyse(contract *Contract) {
+	if contract.analysis != nil {
+		return
+	}
+	if contract.CodeHash == (common.Hash{}) || !in.sharedAnalysis {
+		contract.analysis = analyseCode(contract.Code, in.jumpTable)
+		return
+	}
+	contract.analysis = codeAnalyses.get(contract.CodeHash, contract.Code, in.jumpTable)
+}
@@ -32,7 +32,10 @@ type (
 	memorySizeFunc func(*Stack) (size uint64, overflow bool)
 )
 
-var errGasUintOverflow = errors.New("gas uint64 overflow")
+var (
+	errGasUintOverflow             = errors.New("gas uint64 overflow")
+	errNotEnoughGasForSstoreSentry = errors.New("not enough gas for reentrancy sentry")
+)
 
 type operation struct {
 	// execute is the operation function
@@ -65,8 +68,33 @@ var (
 	homesteadInstructionSet      = newHomesteadInstructionSet()
 	byzantiumInstructionSet      = newByzantiumInstructionSet()
 	ConstantinopleInstructionSet = newConstantinopleInstructionSet()
+	IstanbulInstructionSet       = newIstanbulInstructionSet()
 )
 
+// newIstanbulInstructionSet returns the constantinople instructions and
+// the instructions introduced by the Istanbul fork (EIP-1344, EIP-1884 and EIP-2200).
+func newIstanbulInstructionSet() [256]operation {
+	instructionSet := newConstantinopleInstructionSet()
+	instructionSet[CHAINID] = operation{
+		execute:         opChainID,
+		constantGas:     GasQuickStep,
+		minStack:        minStack(0, 1),
+		maxStack:        maxStack(0, 1),
+		valid:           true,
+		computationCost: params.ChainIDComputationCost,
+	}
+	instructionSet[SELFBALANCE] = operation{
+		execute:         opSelfBalance,
+		constantGas:     GasFastStep,
+		minStack:        minStack(0, 1),
+		maxStack:        maxStack(0, 1),
+		valid:           true,
+		computationCost: params.SelfBalanceComputationCost,
+	}
+	instructionSet[SSTORE].dynamicGas = gasSStoreEIP2200
+	return instructionSet
+}
+
 // NewConstantinopleInstructionSet returns the frontier, homestead
 // byzantium and contantinople instructions.
 func newConstantinopleInstructionSet() [256]operation {
@@ -195,3 +195,35 @@ func BenchmarkEVM_CREATE2_1200(bench *testing.B) {
 	// initcode size 1200K, repeatedly calls CREATE2 and then modifies the mem contents
 	benchmarkEVM_Create(bench, "5b5862124f80600080f5600152600056")
 }
+
+// TestBasicBlockGas checks that charging the constant gas of a basic block at once
+// leaves the same gas and returns the same error as charging it operation by operation.
+func TestBasicBlockGas(t *testing.T) {
+	code := []byte{
+		byte(vm.PUSH1), 0x10, // counter
+		byte(vm.JUMPDEST), // loop: counter
+		byte(vm.PUSH1), 0x01, byte(vm.SWAP1), byte(vm.SUB),
+		byte(vm.PUSH1), 0x00, byte(vm.DUP2), byte(vm.SWAP1), byte(vm.MSTORE), // dynamic gas ends a block
+		byte(vm.GAS), byte(vm.POP),
+		byte(vm.DUP1), byte(vm.PUSH1), 0x02, byte(vm.JUMPI),
+		byte(vm.STOP),
+	}
+	address := common.HexToAddress("0x0a00")
+
+	call := func(gasLimit uint64, noCache bool) (uint64, error) {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+		statedb.SetCode(address, code)
+		_, leftOverGas, err := Call(address, nil, &Config{State: statedb, GasLimit: gasLimit, EVMConfig: vm.Config{NoCodeAnalysisCache: noCache}})
+		return leftOverGas, err
+	}
+	for gasLimit := uint64(0); gasLimit < 1000; gasLimit++ {
+		expectedGas, expectedErr := call(gasLimit, true)
+		gas, err := call(gasLimit, false)
+		if gas != expectedGas || err != expectedErr {
+			t.Fatalf("gas limit %d: expected (%d, %v), got (%d, %v)", gasLimit, expectedGas, expectedErr, gas, err)
+		}
+	}
+	if _, err := call(1000, false); err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/node/sc"
 	"math/big"
 )
 
@@ -261,6 +262,13 @@ func (ec *Client) BridgeSetERC20Fee(ctx context.Context, bridgeAddr, tokenAddr c
 	return result, err
 }
 
+// BridgeSetERC1155Fee can set the ERC1155 transfer fee.
+func (ec *Client) BridgeSetERC1155Fee(ctx context.Context, bridgeAddr, tokenAddr common.Address, fee *big.Int) (common.Hash, error) {
+	var result common.Hash
+	err := ec.c.CallContext(ctx, &result, "subbridge_setERC1155Fee", bridgeAddr, tokenAddr, fee)
+	return result, err
+}
+
 // BridgeSetKLAYFee can set the KLAY transfer fee.
 func (ec *Client) BridgeSetKLAYFee(ctx context.Context, bridgeAddr common.Address, fee *big.Int) (common.Hash, error) {
 	var result common.Hash
@@ -275,6 +283,13 @@ func (ec *Client) BridgeGetERC20Fee(ctx context.Context, bridgeAddr, tokenAddr c
 	return (*big.Int)(&result), err
 }
 
+// BridgeGetERC1155Fee returns the ERC1155 transfer fee.
+func (ec *Client) BridgeGetERC1155Fee(ctx context.Context, bridgeAddr, tokenAddr common.Address) (*big.Int, error) {
+	var result hexutil.Big
+	err := ec.c.CallContext(ctx, &result, "subbridge_getERC1155Fee", bridgeAddr, tokenAddr)
+	return (*big.Int)(&result), err
+}
+
 // BridgeGetKLAYFee returns the KLAY transfer fee.
 func (ec *Client) BridgeGetKLAYFee(ctx context.Context, bridgeAddr common.Address) (*big.Int, error) {
 	var result hexutil.Big
@@ -295,3 +310,32 @@ func (ec *Client) BridgeGetFeeReceiver(ctx context.Context, bridgeAddr common.Ad
 	err := ec.c.CallContext(ctx, &result, "subbridge_getFeeReceiver", bridgeAddr)
 	return result, err
 }
+
+// BridgeGetAnchoringProof returns the anchoring proof of the given child chain transaction.
+func (ec *Client) BridgeGetAnchoringProof(ctx context.Context, txHash common.Hash) (*sc.AnchoringProof, error) {
+	var result *sc.AnchoringProof
+	err := ec.c.CallContext(ctx, &result, "subbridge_getAnchoringProof", txHash)
+	if err == nil && result == nil {
+		return nil, klaytn.NotFound
+	}
+	return result, err
+}
+
+// VerifyAnchoringProof verifies the anchoring proof with the chain data anchoring transaction
+// read from the parent chain that the client is connected to. The anchoring transaction should
+// be mined successfully and sent by the given anchoring address of the child chain.
+// It returns the proven child chain transaction and receipt.
+func (ec *Client) VerifyAnchoringProof(ctx context.Context, proof *sc.AnchoringProof, anchoringAddr common.Address) (*types.Transaction, *types.Receipt, error) {
+	anchoringTx, isPending, err := ec.TransactionByHash(ctx, proof.AnchoringTxHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isPending {
+		return nil, nil, sc.ErrAnchoringTxPending
+	}
+	anchoringReceipt, err := ec.TransactionReceipt(ctx, proof.AnchoringTxHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sc.VerifyAnchoringProof(proof, anchoringTx, anchoringReceipt, anchoringAddr)
+}
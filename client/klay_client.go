@@ -28,6 +28,7 @@ import (
 	"github.com/klaytn/klaytn"
 	"github.com/klaytn/klaytn/api"
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/networks/rpc"
@@ -331,6 +332,51 @@ func (ec *Client) CodeAt(ctx context.Context, account common.Address, blockNumbe
 	return result, err
 }
 
+// IsHumanReadable returns true if the account is a registered human-readable address.
+// The block number can be nil, in which case the account is checked at the latest known block.
+func (ec *Client) IsHumanReadable(ctx context.Context, account common.Address, blockNumber *big.Int) (bool, error) {
+	var result bool
+	err := ec.c.CallContext(ctx, &result, "klay_isHumanReadable", account, toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// ResolveHumanReadable returns the address of the registered human-readable address like "colin.klaytn".
+// The block number can be nil, in which case the address is resolved at the latest known block.
+func (ec *Client) ResolveHumanReadable(ctx context.Context, hra string, blockNumber *big.Int) (common.Address, error) {
+	var result common.Address
+	err := ec.c.CallContext(ctx, &result, "klay_resolveHumanReadable", hra, toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// GetHumanReadable returns the human-readable address registered at the account.
+// The block number can be nil, in which case the account is checked at the latest known block.
+func (ec *Client) GetHumanReadable(ctx context.Context, account common.Address, blockNumber *big.Int) (string, error) {
+	var result string
+	err := ec.c.CallContext(ctx, &result, "klay_getHumanReadable", account, toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// ValidateSignatures returns true if the signatures of the message hash are valid for the role
+// of the account key of the account, in the same way as the signatures of a transaction.
+// The block number can be nil, in which case the account key is taken from the latest known block.
+func (ec *Client) ValidateSignatures(ctx context.Context, account common.Address, msgHash common.Hash, sigs [][]byte, role accountkey.RoleType, blockNumber *big.Int) (bool, error) {
+	hexSigs := make([]hexutil.Bytes, len(sigs))
+	for i, sig := range sigs {
+		hexSigs[i] = sig
+	}
+	var result bool
+	err := ec.c.CallContext(ctx, &result, "klay_validateSignatures", account, msgHash, hexSigs, role, toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// GetAccountKeyHistory returns the account key changes of the account in the order of the changes.
+// The node should have the account key history indexing enabled.
+func (ec *Client) GetAccountKeyHistory(ctx context.Context, account common.Address) ([]*api.AccountKeyChange, error) {
+	var result []*api.AccountKeyChange
+	err := ec.c.CallContext(ctx, &result, "klay_getAccountKeyHistory", account)
+	return result, err
+}
+
 // NonceAt returns the account nonce of the given account.
 // The block number can be nil, in which case the nonce is taken from the latest known block.
 func (ec *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
@@ -533,6 +579,41 @@ func (ec *Client) UnlockAccount(ctx context.Context, address common.Address, pas
 	return result, err
 }
 
+// SignTxEnvelope adds the sender signature of an account on Klaytn node to the envelope.
+func (ec *Client) SignTxEnvelope(ctx context.Context, env *types.TxEnvelope, password string) (*types.TxEnvelope, error) {
+	var result types.TxEnvelope
+	if err := ec.c.CallContext(ctx, &result, "personal_signTxEnvelope", env, password); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SignTxEnvelopeAsFeePayer adds the fee payer signature of an account on Klaytn node to the envelope.
+func (ec *Client) SignTxEnvelopeAsFeePayer(ctx context.Context, env *types.TxEnvelope, password string) (*types.TxEnvelope, error) {
+	var result types.TxEnvelope
+	if err := ec.c.CallContext(ctx, &result, "personal_signTxEnvelopeAsFeePayer", env, password); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// InspectTxEnvelope returns the weights of the signatures collected in the envelope
+// against the thresholds of the sender and the fee payer.
+func (ec *Client) InspectTxEnvelope(ctx context.Context, env *types.TxEnvelope) (*api.TxEnvelopeWeights, error) {
+	var result api.TxEnvelopeWeights
+	if err := ec.c.CallContext(ctx, &result, "klay_inspectTxEnvelope", env); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SendTxEnvelope injects the transaction assembled from the envelope into the pending pool.
+func (ec *Client) SendTxEnvelope(ctx context.Context, env *types.TxEnvelope) (common.Hash, error) {
+	var hash common.Hash
+	err := ec.c.CallContext(ctx, &hash, "klay_sendTxEnvelope", env)
+	return hash, err
+}
+
 func toCallArg(msg klaytn.CallMsg) interface{} {
 	arg := map[string]interface{}{
 		"from": msg.From,
@@ -0,0 +1,368 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrFeeDelegationNotSupported = errors.New("the transaction type does not support fee delegation")
+	ErrNoSenderWallet            = errors.New("the wallet of the sender is not given")
+	ErrNoFeePayerWallet          = errors.New("the wallet of the fee payer is not given")
+)
+
+// receiptPollingInterval is the interval to check if a sent transaction is mined.
+const receiptPollingInterval = time.Second
+
+// TxBuilder builds a Klaytn transaction of a specific type. The values not given to the
+// builder, i.e., the nonce, the gas price and the gas limit, are filled from the node when
+// the transaction is built. It signs the transaction with the wallets of the sender and the
+// fee payer, and sends it to the node.
+//
+// The gas limit estimated by the builder does not include the gas to validate the signatures
+// of the accounts with multiple keys. Set the gas limit explicitly for such accounts.
+type TxBuilder struct {
+	ec     *Client
+	txType types.TxType
+	from   common.Address
+	values map[types.TxValueKeyType]interface{}
+
+	wallet         accounts.Wallet
+	feePayerWallet accounts.Wallet
+
+	err error
+}
+
+func (ec *Client) newTxBuilder(txType types.TxType, from common.Address) *TxBuilder {
+	return &TxBuilder{
+		ec:     ec,
+		txType: txType,
+		from:   from,
+		values: map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyFrom: from,
+		},
+	}
+}
+
+// NewValueTransferTx returns a builder of a transaction sending KLAY to an account.
+func (ec *Client) NewValueTransferTx(from, to common.Address, value *big.Int) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeValueTransfer, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(value)
+	return b
+}
+
+// NewValueTransferMemoTx returns a builder of a transaction sending KLAY to an account with a memo.
+func (ec *Client) NewValueTransferMemoTx(from, to common.Address, value *big.Int, memo []byte) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeValueTransferMemo, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(value)
+	b.values[types.TxValueKeyData] = common.CopyBytes(memo)
+	return b
+}
+
+// NewHumanReadableAccountCreationTx returns a builder of a transaction registering the human-readable
+// address like "colin.klaytn" with the account key. The key should not be a legacy key.
+func (ec *Client) NewHumanReadableAccountCreationTx(from common.Address, hra string, value *big.Int, key accountkey.AccountKey) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeAccountCreation, from)
+	to, err := common.HumanReadableToAddress(hra)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(value)
+	b.values[types.TxValueKeyHumanReadable] = true
+	b.values[types.TxValueKeyAccountKey] = key
+	return b
+}
+
+// NewAccountUpdateTx returns a builder of a transaction updating the account key of the sender.
+func (ec *Client) NewAccountUpdateTx(from common.Address, key accountkey.AccountKey) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeAccountUpdate, from)
+	b.values[types.TxValueKeyAccountKey] = key
+	return b
+}
+
+// NewSmartContractDeployTx returns a builder of a transaction deploying an EVM contract.
+func (ec *Client) NewSmartContractDeployTx(from common.Address, value *big.Int, code []byte) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeSmartContractDeploy, from)
+	b.values[types.TxValueKeyTo] = (*common.Address)(nil)
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(value)
+	b.values[types.TxValueKeyData] = common.CopyBytes(code)
+	b.values[types.TxValueKeyHumanReadable] = false
+	b.values[types.TxValueKeyCodeFormat] = params.CodeFormatEVM
+	return b
+}
+
+// NewSmartContractExecutionTx returns a builder of a transaction executing a contract with the input.
+func (ec *Client) NewSmartContractExecutionTx(from, to common.Address, value *big.Int, input []byte) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeSmartContractExecution, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(value)
+	b.values[types.TxValueKeyData] = common.CopyBytes(input)
+	return b
+}
+
+// NewCancelTx returns a builder of a transaction cancelling the pending transaction of the
+// sender with the same nonce. The nonce should be given by Nonce.
+func (ec *Client) NewCancelTx(from common.Address) *TxBuilder {
+	return ec.newTxBuilder(types.TxTypeCancel, from)
+}
+
+// NewChainDataAnchoringTx returns a builder of a transaction anchoring the data of a service chain.
+func (ec *Client) NewChainDataAnchoringTx(from common.Address, data []byte) *TxBuilder {
+	b := ec.newTxBuilder(types.TxTypeChainDataAnchoring, from)
+	b.values[types.TxValueKeyAnchoredData] = common.CopyBytes(data)
+	return b
+}
+
+// Nonce sets the nonce of the transaction. The pending nonce of the sender is used if not set.
+func (b *TxBuilder) Nonce(nonce uint64) *TxBuilder {
+	b.values[types.TxValueKeyNonce] = nonce
+	return b
+}
+
+// Gas sets the gas limit of the transaction. The gas limit is estimated if not set.
+func (b *TxBuilder) Gas(gas uint64) *TxBuilder {
+	b.values[types.TxValueKeyGasLimit] = gas
+	return b
+}
+
+// GasPrice sets the gas price of the transaction. The gas price suggested by the node is used if not set.
+func (b *TxBuilder) GasPrice(gasPrice *big.Int) *TxBuilder {
+	b.values[types.TxValueKeyGasPrice] = new(big.Int).Set(gasPrice)
+	return b
+}
+
+// FeePayer makes the transaction a fee-delegated transaction paid by the fee payer.
+func (b *TxBuilder) FeePayer(feePayer common.Address) *TxBuilder {
+	if !b.supportsFeeDelegation() {
+		b.err = ErrFeeDelegationNotSupported
+		return b
+	}
+	if !b.txType.IsFeeDelegatedTransaction() {
+		b.txType = b.baseTxType() + 1
+	}
+	b.values[types.TxValueKeyFeePayer] = feePayer
+	return b
+}
+
+// FeeRatio makes the transaction a partially fee-delegated transaction. The fee payer pays
+// the given ratio of the fee in percent, and the sender pays the rest.
+func (b *TxBuilder) FeeRatio(ratio types.FeeRatio) *TxBuilder {
+	if !b.supportsFeeDelegation() {
+		b.err = ErrFeeDelegationNotSupported
+		return b
+	}
+	b.txType = b.baseTxType() + 2
+	b.values[types.TxValueKeyFeeRatioOfFeePayer] = ratio
+	return b
+}
+
+// Wallet sets the wallet signing the transaction as the sender.
+func (b *TxBuilder) Wallet(wallet accounts.Wallet) *TxBuilder {
+	b.wallet = wallet
+	return b
+}
+
+// FeePayerWallet sets the wallet signing the fee-delegated transaction as the fee payer.
+func (b *TxBuilder) FeePayerWallet(wallet accounts.Wallet) *TxBuilder {
+	b.feePayerWallet = wallet
+	return b
+}
+
+// Build returns the unsigned transaction. The values not given to the builder are filled from the node.
+func (b *TxBuilder) Build(ctx context.Context) (*types.Transaction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	values := copyValues(b.values)
+
+	if _, ok := values[types.TxValueKeyNonce]; !ok {
+		nonce, err := b.ec.PendingNonceAt(ctx, b.from)
+		if err != nil {
+			return nil, err
+		}
+		values[types.TxValueKeyNonce] = nonce
+	}
+	if _, ok := values[types.TxValueKeyGasPrice]; !ok {
+		gasPrice, err := b.ec.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values[types.TxValueKeyGasPrice] = gasPrice
+	}
+	if _, ok := values[types.TxValueKeyGasLimit]; ok {
+		return types.NewTransactionWithMap(b.txType, copyValues(values))
+	}
+
+	// Build the transaction without the gas limit first to estimate it.
+	values[types.TxValueKeyGasLimit] = uint64(0)
+	tx, err := types.NewTransactionWithMap(b.txType, copyValues(values))
+	if err != nil {
+		return nil, err
+	}
+	gas, err := b.estimateGas(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	values[types.TxValueKeyGasLimit] = gas
+	return types.NewTransactionWithMap(b.txType, values)
+}
+
+// Sign returns the transaction signed by the sender, and also by the fee payer if the wallet
+// of the fee payer is given. The transaction signed only by the sender can be handed over to
+// the fee payer.
+func (b *TxBuilder) Sign(ctx context.Context) (*types.Transaction, error) {
+	if b.wallet == nil {
+		return nil, ErrNoSenderWallet
+	}
+	tx, err := b.Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := b.ec.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = b.wallet.SignTx(accounts.Account{Address: b.from}, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if b.feePayerWallet != nil && tx.IsFeeDelegatedTransaction() {
+		feePayer, err := tx.FeePayer()
+		if err != nil {
+			return nil, err
+		}
+		tx, err = b.feePayerWallet.SignTxAsFeePayer(accounts.Account{Address: feePayer}, tx, chainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// Send signs the transaction and sends it to the node. The wallet of the fee payer should be
+// given for a fee-delegated transaction.
+func (b *TxBuilder) Send(ctx context.Context) (*types.Transaction, error) {
+	if b.txType.IsFeeDelegatedTransaction() && b.feePayerWallet == nil {
+		return nil, ErrNoFeePayerWallet
+	}
+	tx, err := b.Sign(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.ec.SendRawTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SendAndWait sends the transaction and waits until its receipt is available.
+func (b *TxBuilder) SendAndWait(ctx context.Context) (*types.Receipt, error) {
+	tx, err := b.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.ec.WaitMined(ctx, tx.Hash())
+}
+
+// estimateGas returns the intrinsic gas of the transaction added by the gas to run the EVM
+// estimated by the node.
+func (b *TxBuilder) estimateGas(ctx context.Context, tx *types.Transaction) (uint64, error) {
+	blockNumber, err := b.ec.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := tx.IntrinsicGas(blockNumber.Uint64())
+	if err != nil {
+		return 0, err
+	}
+	if base := b.baseTxType(); base != types.TxTypeSmartContractDeploy && base != types.TxTypeSmartContractExecution {
+		return gas, nil
+	}
+
+	// The node estimates the gas of the call including the intrinsic gas of a legacy transaction.
+	msg := klaytn.CallMsg{From: b.from, To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+	estimated, err := b.ec.EstimateGas(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+	legacyGas, err := types.IntrinsicGas(msg.Data, msg.To == nil, true)
+	if err != nil {
+		return 0, err
+	}
+	if estimated > legacyGas {
+		gas += estimated - legacyGas
+	}
+	return gas, nil
+}
+
+func (b *TxBuilder) baseTxType() types.TxType {
+	return b.txType &^ ((1 << types.SubTxTypeBits) - 1)
+}
+
+func (b *TxBuilder) supportsFeeDelegation() bool {
+	switch b.baseTxType() {
+	case types.TxTypeValueTransfer, types.TxTypeValueTransferMemo, types.TxTypeAccountUpdate,
+		types.TxTypeSmartContractDeploy, types.TxTypeSmartContractExecution, types.TxTypeCancel:
+		return true
+	}
+	return false
+}
+
+// copyValues copies the values since types.NewTransactionWithMap consumes the given map.
+func copyValues(values map[types.TxValueKeyType]interface{}) map[types.TxValueKeyType]interface{} {
+	copied := make(map[types.TxValueKeyType]interface{}, len(values))
+	for k, v := range values {
+		copied[k] = v
+	}
+	return copied
+}
+
+// WaitMined waits until the receipt of the transaction is available, and returns it.
+func (ec *Client) WaitMined(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(receiptPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := ec.TransactionReceipt(ctx, txHash)
+		if receipt != nil {
+			return receipt, nil
+		}
+		if err != nil && err != klaytn.NotFound {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+)
+
+var testChainID = big.NewInt(1000)
+
+// KlayTestAPI serves the part of the klay namespace used by TxBuilder.
+type KlayTestAPI struct {
+	nonce       uint64
+	estimateGas uint64
+	sent        []*types.Transaction
+}
+
+func (s *KlayTestAPI) GetTransactionCount(addr common.Address, blockNr string) hexutil.Uint64 {
+	return hexutil.Uint64(s.nonce)
+}
+
+func (s *KlayTestAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(25))
+}
+
+func (s *KlayTestAPI) ChainID() *hexutil.Big {
+	return (*hexutil.Big)(testChainID)
+}
+
+func (s *KlayTestAPI) BlockNumber() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (s *KlayTestAPI) EstimateGas(args map[string]interface{}) hexutil.Uint64 {
+	return hexutil.Uint64(s.estimateGas)
+}
+
+func (s *KlayTestAPI) SendRawTransaction(encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	s.sent = append(s.sent, tx)
+	return tx.Hash(), nil
+}
+
+func (s *KlayTestAPI) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	for _, tx := range s.sent {
+		if tx.Hash() == hash {
+			return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: hash, Logs: []*types.Log{}}
+		}
+	}
+	return nil
+}
+
+func newTestBuilderClient(t *testing.T) (*Client, *KlayTestAPI, *keystore.KeyStore, func()) {
+	service := &KlayTestAPI{nonce: 5}
+	server := rpc.NewServer()
+	if err := server.RegisterName("klay", service); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "klaytn-txbuilder-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+	for i := 0; i < 2; i++ {
+		acc, err := ks.NewAccount("foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ks.Unlock(acc, "foo"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewClient(rpc.DialInProc(server)), service, ks, func() {
+		server.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestTxBuilder_FeeDelegatedValueTransfer(t *testing.T) {
+	ec, service, ks, closeFn := newTestBuilderClient(t)
+	defer closeFn()
+
+	wallets := ks.Wallets()
+	sender, feePayer := wallets[0].Accounts()[0].Address, wallets[1].Accounts()[0].Address
+	to := common.HexToAddress("0x1")
+
+	receipt, err := ec.NewValueTransferTx(sender, to, big.NewInt(10)).
+		FeePayer(feePayer).FeeRatio(30).
+		Wallet(wallets[0]).FeePayerWallet(wallets[1]).
+		SendAndWait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	assert.Equal(t, 1, len(service.sent))
+	tx := service.sent[0]
+	assert.Equal(t, types.TxTypeFeeDelegatedValueTransferWithRatio, tx.Type())
+	assert.Equal(t, uint64(5), tx.Nonce())
+	assert.Equal(t, big.NewInt(25), tx.GasPrice())
+	intrinsic, _ := tx.IntrinsicGas(1)
+	assert.Equal(t, intrinsic, tx.Gas())
+	ratio, _ := tx.FeeRatio()
+	assert.Equal(t, types.FeeRatio(30), ratio)
+
+	signer := types.NewEIP155Signer(testChainID)
+	pubkeys, err := signer.SenderPubkey(tx)
+	assert.NoError(t, err)
+	assert.Equal(t, sender, crypto.PubkeyToAddress(*pubkeys[0]))
+	pubkeys, err = signer.SenderFeePayer(tx)
+	assert.NoError(t, err)
+	assert.Equal(t, feePayer, crypto.PubkeyToAddress(*pubkeys[0]))
+}
+
+func TestTxBuilder_SmartContractExecution(t *testing.T) {
+	ec, service, ks, closeFn := newTestBuilderClient(t)
+	defer closeFn()
+
+	service.estimateGas = 50000
+	sender := ks.Accounts()[0].Address
+	input := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	tx, err := ec.NewSmartContractExecutionTx(sender, common.HexToAddress("0x2"), big.NewInt(0), input).
+		Nonce(7).
+		Build(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeSmartContractExecution, tx.Type())
+	assert.Equal(t, uint64(7), tx.Nonce())
+
+	intrinsic, _ := tx.IntrinsicGas(1)
+	legacy, _ := types.IntrinsicGas(input, false, true)
+	assert.Equal(t, intrinsic+service.estimateGas-legacy, tx.Gas())
+
+	// The given gas limit is used as it is.
+	tx, err = ec.NewSmartContractExecutionTx(sender, common.HexToAddress("0x2"), big.NewInt(0), input).
+		Gas(100000).
+		Build(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100000), tx.Gas())
+}
+
+func TestTxBuilder_Errors(t *testing.T) {
+	ec, _, ks, closeFn := newTestBuilderClient(t)
+	defer closeFn()
+
+	wallets := ks.Wallets()
+	sender, feePayer := wallets[0].Accounts()[0].Address, wallets[1].Accounts()[0].Address
+
+	_, err := ec.NewChainDataAnchoringTx(sender, []byte{0x01}).FeePayer(feePayer).Build(context.Background())
+	assert.Equal(t, ErrFeeDelegationNotSupported, err)
+
+	_, err = ec.NewCancelTx(sender).Nonce(0).Sign(context.Background())
+	assert.Equal(t, ErrNoSenderWallet, err)
+
+	// A fee-delegated transaction cannot be sent without the signature of the fee payer,
+	// but it can be signed by the sender to be handed over to the fee payer.
+	builder := ec.NewValueTransferMemoTx(sender, common.HexToAddress("0x1"), big.NewInt(1), []byte("memo")).
+		FeePayer(feePayer).Wallet(wallets[0])
+	_, err = builder.Send(context.Background())
+	assert.Equal(t, ErrNoFeePayerWallet, err)
+
+	tx, err := builder.Sign(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedValueTransferMemo, tx.Type())
+	assert.Equal(t, 0, len(tx.RawFeePayerSignatureValues()))
+}
+
+func TestTxBuilder_HumanReadableAccountCreation(t *testing.T) {
+	ec, _, ks, closeFn := newTestBuilderClient(t)
+	defer closeFn()
+
+	sender := ks.Accounts()[0].Address
+	prv, _ := crypto.GenerateKey()
+	key := accountkey.NewAccountKeyPublicWithValue(&prv.PublicKey)
+
+	_, err := ec.NewHumanReadableAccountCreationTx(sender, "abc.klaytn", big.NewInt(0), key).Build(context.Background())
+	assert.Equal(t, common.ErrHumanReadableNameTooShort, err)
+
+	tx, err := ec.NewHumanReadableAccountCreationTx(sender, "colin.klaytn", big.NewInt(0), key).Build(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeAccountCreation, tx.Type())
+	assert.Equal(t, common.BytesToAddress([]byte("colin.klaytn")), *tx.To())
+
+	intrinsic, _ := tx.IntrinsicGas(1)
+	assert.Equal(t, intrinsic, tx.Gas())
+	assert.True(t, tx.Gas() > params.TxGasHumanReadable)
+}
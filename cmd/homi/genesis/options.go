@@ -151,6 +151,26 @@ func DeriveShaImpl(impl int) Option {
 	}
 }
 
+// IstanbulCompatibleBlock schedules the Istanbul hard fork at the given block number.
+// A negative block number leaves the hard fork unscheduled.
+func IstanbulCompatibleBlock(num int64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if num >= 0 {
+			genesis.Config.IstanbulCompatibleBlock = big.NewInt(num)
+		}
+	}
+}
+
+// HumanReadableCompatibleBlock schedules the human-readable address hard fork at the given block number.
+// A negative block number leaves the hard fork unscheduled.
+func HumanReadableCompatibleBlock(num int64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if num >= 0 {
+			genesis.Config.HumanReadableCompatibleBlock = big.NewInt(num)
+		}
+	}
+}
+
 func Governance(config *params.GovernanceConfig) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.Governance = config
@@ -93,6 +93,8 @@ Args :
 			serviceChainIDFlag,
 			unitPriceFlag,
 			deriveShaImplFlag,
+			istanbulCompatibleBlockNumberFlag,
+			humanReadableCompatibleBlockNumberFlag,
 			fundingAddrFlag,
 			outputPathFlag,
 			dockerImageIdFlag,
@@ -244,6 +246,8 @@ func genIstanbulGenesis(ctx *cli.Context, nodeAddrs, testAddrs []common.Address,
 		genesis.DeriveShaImpl(deriveShaImpl),
 		genesis.UnitPrice(unitPrice),
 		genesis.ChainID(chainID),
+		genesis.IstanbulCompatibleBlock(ctx.Int64(istanbulCompatibleBlockNumberFlag.Name)),
+		genesis.HumanReadableCompatibleBlock(ctx.Int64(humanReadableCompatibleBlockNumberFlag.Name)),
 	}
 
 	if ok := ctx.Bool(governanceFlag.Name); ok {
@@ -268,6 +272,8 @@ func genCliqueGenesis(ctx *cli.Context, nodeAddrs, testAddrs []common.Address, c
 		genesis.Alloc(append(nodeAddrs, testAddrs...), new(big.Int).Exp(big.NewInt(10), big.NewInt(50), nil)),
 		genesis.UnitPrice(unitPrice),
 		genesis.ChainID(chainID),
+		genesis.IstanbulCompatibleBlock(ctx.Int64(istanbulCompatibleBlockNumberFlag.Name)),
+		genesis.HumanReadableCompatibleBlock(ctx.Int64(humanReadableCompatibleBlockNumberFlag.Name)),
 		genesis.Clique(config),
 	)
 	return genesisJson
@@ -134,6 +134,18 @@ var (
 		Value: 0,
 	}
 
+	istanbulCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "istanbul-compatible-blocknumber",
+		Usage: "Block number at which the Istanbul hard fork is activated (-1 = not scheduled)",
+		Value: -1,
+	}
+
+	humanReadableCompatibleBlockNumberFlag = cli.Int64Flag{
+		Name:  "human-readable-compatible-blocknumber",
+		Usage: "Block number at which human-readable addresses are activated (-1 = not scheduled)",
+		Value: -1,
+	}
+
 	outputPathFlag = cli.StringFlag{
 		Name:        "output, o",
 		Usage:       "homi's result saved at this output folder",
@@ -85,3 +85,26 @@ func (api *BootnodeAPI) PutAuthorizedNodes(rawurl string) error {
 func (api *BootnodeAPI) DeleteAuthorizedNodes(rawurl string) error {
 	return api.bn.DeleteAuthorizedNodes(rawurl)
 }
+
+// ListAuthorizedNodes returns the authorized nodes with their roles and expiry times.
+func (api *BootnodeAPI) ListAuthorizedNodes() []*discover.AuthorizedNode {
+	return api.bn.ListAuthorizedNodes()
+}
+
+// PutAuthorizedNodesWithTTL authorizes the comma separated kni URLs for ttl seconds.
+func (api *BootnodeAPI) PutAuthorizedNodesWithTTL(rawurl string, ttl uint64) error {
+	return api.bn.PutAuthorizedNodesWithTTL(rawurl, ttl)
+}
+
+// ImportAuthorizedNodes authorizes the nodes listed in the file at path, and returns
+// the number of the nodes. Each line of the file has a kni URL followed by an optional
+// role (CN, PN, EN or BN) and an optional expiry time in RFC3339.
+func (api *BootnodeAPI) ImportAuthorizedNodes(path string) (int, error) {
+	return api.bn.ImportAuthorizedNodes(path)
+}
+
+// GetAuthorizedNodeAudits returns at most max records of the changes of the authorized
+// nodes, the latest first. It returns all the records if max is not positive.
+func (api *BootnodeAPI) GetAuthorizedNodeAudits(max int) []*discover.AuthorizedNodeAudit {
+	return api.bn.GetAuthorizedNodeAudits(max)
+}
@@ -20,9 +20,14 @@ import (
 	"fmt"
 	"github.com/klaytn/klaytn/networks/p2p/discover"
 	"github.com/klaytn/klaytn/networks/rpc"
+	"math"
 	"strings"
+	"time"
 )
 
+// maxAuthorizedNodeTTL is the longest ttl in seconds which does not overflow time.Duration.
+const maxAuthorizedNodeTTL = uint64(math.MaxInt64 / int64(time.Second))
+
 type BN struct {
 	ntab discover.Discovery
 }
@@ -126,6 +131,40 @@ func (b *BN) DeleteAuthorizedNodes(rawurl string) error {
 	return nil
 }
 
+func (b *BN) ListAuthorizedNodes() []*discover.AuthorizedNode {
+	return b.ntab.ListAuthorizedNodes()
+}
+
+func (b *BN) PutAuthorizedNodesWithTTL(rawurl string, ttl uint64) error {
+	if ttl == 0 || ttl > maxAuthorizedNodeTTL {
+		return fmt.Errorf("ttl must be between 1 and %d seconds. ttl: %d", maxAuthorizedNodeTTL, ttl)
+	}
+	nodes, err := parseNodeList(rawurl)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	entries := make([]*discover.AuthorizedNode, 0, len(nodes))
+	for _, node := range nodes {
+		entries = append(entries, &discover.AuthorizedNode{Node: node, AddedAt: now, ExpiresAt: now.Add(time.Duration(ttl) * time.Second)})
+	}
+	b.ntab.PutAuthorizedNodeEntries(entries)
+	return nil
+}
+
+func (b *BN) ImportAuthorizedNodes(path string) (int, error) {
+	entries, err := discover.LoadAuthorizedNodesFile(path)
+	if err != nil {
+		return 0, err
+	}
+	b.ntab.PutAuthorizedNodeEntries(entries)
+	return len(entries), nil
+}
+
+func (b *BN) GetAuthorizedNodeAudits(max int) []*discover.AuthorizedNodeAudit {
+	return b.ntab.GetAuthorizedNodeAudits(max)
+}
+
 func (b *BN) APIs() []rpc.API {
 	return []rpc.API{
 		{
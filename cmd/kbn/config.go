@@ -39,6 +39,8 @@ const (
 	DefaultWSPort   = 8552        // Default TCP port for the websocket RPC server
 	DefaultGRPCHost = "localhost" // Default host interface for the gRPC server
 	DefaultGRPCPort = 8553        // Default TCP port for the gRPC server
+
+	datadirNodeDatabase = "nodes" // Path within the datadir to store the node infos
 )
 
 type bootnodeConfig struct {
@@ -403,6 +405,16 @@ func DefaultIPCEndpoint(clientIdentifier string) string {
 	return config.IPCEndpoint()
 }
 
+// NodeDB returns the path to the discovery node database, which keeps the authorized
+// nodes across restarts. It returns an empty path, using an in-memory database, if no
+// data directory is set.
+func (c *bootnodeConfig) NodeDB() string {
+	if c.DataDir == "" {
+		return ""
+	}
+	return filepath.Join(c.DataDir, datadirNodeDatabase)
+}
+
 // HTTPEndpoint resolves an HTTP endpoint based on the configured host interface
 // and port parameters.
 func (c *bootnodeConfig) HTTPEndpoint() string {
@@ -139,6 +139,7 @@ func bootnode(ctx *cli.Context) error {
 		PrivateKey:      bcfg.nodeKey,
 		AnnounceAddr:    realaddr,
 		NetRestrict:     bcfg.restrictList,
+		NodeDBPath:      bcfg.NodeDB(),
 		Conn:            conn,
 		Addr:            realaddr,
 		Id:              discover.PubkeyID(&bcfg.nodeKey.PublicKey),
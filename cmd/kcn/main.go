@@ -51,6 +51,7 @@ var cnHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -92,6 +93,7 @@ var cnHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -134,6 +136,9 @@ var cnHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -155,6 +160,7 @@ var cnHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
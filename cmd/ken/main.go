@@ -51,6 +51,7 @@ var enHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -68,6 +69,14 @@ var enHelpFlagGroups = []utils.FlagGroup{
 			utils.MainBridgeListenPortFlag,
 		},
 	},
+	{
+		Name: "FEE PAYER",
+		Flags: []cli.Flag{
+			utils.FeePayerFlag,
+			utils.FeePayerAccountFlag,
+			utils.FeePayerPolicyFlag,
+		},
+	},
 	{
 		Name: "ACCOUNT",
 		Flags: []cli.Flag{
@@ -103,6 +112,7 @@ var enHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -145,6 +155,9 @@ var enHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -166,6 +179,7 @@ var enHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
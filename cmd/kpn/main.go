@@ -59,6 +59,7 @@ var pnHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -103,6 +104,7 @@ var pnHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -139,6 +141,9 @@ var pnHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -160,6 +165,7 @@ var pnHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
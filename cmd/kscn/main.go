@@ -51,6 +51,7 @@ var scnHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -70,6 +71,9 @@ var scnHelpFlagGroups = []utils.FlagGroup{
 			utils.SubBridgeFlag,
 			utils.SubBridgeListenPortFlag,
 			utils.AnchoringPeriodFlag,
+			utils.AnchoringBatchFlag,
+			utils.ParentOperatorSignerFlag,
+			utils.ChildOperatorSignerFlag,
 			utils.SentChainTxsLimit,
 			utils.ParentChainIDFlag,
 			utils.VTRecoveryFlag,
@@ -110,6 +114,7 @@ var scnHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -152,6 +157,9 @@ var scnHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -171,6 +179,7 @@ var scnHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
@@ -51,6 +51,7 @@ var senHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -69,6 +70,9 @@ var senHelpFlagGroups = []utils.FlagGroup{
 			utils.SubBridgeFlag,
 			utils.SubBridgeListenPortFlag,
 			utils.AnchoringPeriodFlag,
+			utils.AnchoringBatchFlag,
+			utils.ParentOperatorSignerFlag,
+			utils.ChildOperatorSignerFlag,
 			utils.SentChainTxsLimit,
 			utils.ParentChainIDFlag,
 			utils.VTRecoveryFlag,
@@ -76,6 +80,14 @@ var senHelpFlagGroups = []utils.FlagGroup{
 			utils.ServiceChainAnchoringFlag,
 		},
 	},
+	{
+		Name: "FEE PAYER",
+		Flags: []cli.Flag{
+			utils.FeePayerFlag,
+			utils.FeePayerAccountFlag,
+			utils.FeePayerPolicyFlag,
+		},
+	},
 	{
 		Name: "ACCOUNT",
 		Flags: []cli.Flag{
@@ -111,6 +123,7 @@ var senHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -153,6 +166,9 @@ var senHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -172,6 +188,7 @@ var senHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
@@ -0,0 +1,51 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+ksigner is a standalone signer holding the private keys on behalf of Klaytn nodes.
+
+A node started with --signer forwards its signing requests to ksigner through the "account"
+namespace of JSON-RPC over IPC or HTTP. ksigner signs a request only if it satisfies the rule
+of the account in the rules file, and optionally if the operator approves it on the terminal.
+
+The rules file is a JSON object from the account addresses to their rules.
+
+	{
+	  "0x75c3098be5e4b63fbac05838daaee378dd48098d": {
+	    "allowedRecipients": ["0x2b6a6bc5e7a48bd3ff4a8d1c1f7b8a2e2ca4d1b3"],
+	    "allowNoRecipient": false,
+	    "maxValue": 1000000000000000000,
+	    "allowFeePayer": true,
+	    "maxFee": 100000000000000000,
+	    "allowSignHash": false
+	  }
+	}
+
+# Options
+
+All available options are as follows.
+
+	--keystore value  Directory for the keystore (default = inside the datadir)
+	--password value  Password file to use for non-interactive password input
+	--rules value     JSON file of the signing rules of the accounts
+	--interactive     Ask the operator to approve each signing request on the terminal
+	--ipcpath value   Filename for the IPC socket (default: "ksigner.ipc")
+	--http            Serve the signer over HTTP instead of IPC
+	--httpaddr value  HTTP listening interface (default: "localhost")
+	--httpport value  HTTP listening port (default: 8550)
+	--help, -h        Show help
+*/
+package main
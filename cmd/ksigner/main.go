@@ -0,0 +1,218 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/klaytn/klaytn/accounts/external"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/cmd/utils/nodecmd"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"gopkg.in/urfave/cli.v1"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+var (
+	logger = log.NewModuleLogger(log.CMDKSIGNER)
+
+	rulesFlag = cli.StringFlag{
+		Name:  "rules",
+		Usage: "JSON file of the signing rules of the accounts",
+	}
+	interactiveFlag = cli.BoolFlag{
+		Name:  "interactive",
+		Usage: "Ask the operator to approve each signing request on the terminal",
+	}
+	ipcPathFlag = cli.StringFlag{
+		Name:  "ipcpath",
+		Usage: "Filename for the IPC socket",
+		Value: "ksigner.ipc",
+	}
+	httpEnabledFlag = cli.BoolFlag{
+		Name:  "http",
+		Usage: "Serve the signer over HTTP instead of IPC",
+	}
+	httpListenAddrFlag = cli.StringFlag{
+		Name:  "httpaddr",
+		Usage: "HTTP listening interface",
+		Value: "localhost",
+	}
+	httpPortFlag = cli.IntFlag{
+		Name:  "httpport",
+		Usage: "HTTP listening port",
+		Value: 8550,
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "ksigner"
+	app.Usage = "The standalone signer holding the private keys on behalf of Klaytn nodes"
+	app.Copyright = "Copyright 2018-2019 The klaytn Authors"
+	app.Action = runSigner
+	app.Flags = []cli.Flag{
+		utils.KeyStoreDirFlag,
+		utils.PasswordFileFlag,
+		rulesFlag,
+		interactiveFlag,
+		ipcPathFlag,
+		httpEnabledFlag,
+		httpListenAddrFlag,
+		httpPortFlag,
+	}
+	app.Commands = []cli.Command{
+		nodecmd.VersionCommand,
+	}
+	app.HideVersion = true
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// runSigner unlocks the accounts of the keystore and serves the signer API until it is interrupted.
+func runSigner(ctx *cli.Context) error {
+	keydir := ctx.GlobalString(utils.KeyStoreDirFlag.Name)
+	if keydir == "" {
+		return errors.New("keystore directory is not given")
+	}
+	rulesFile := ctx.GlobalString(rulesFlag.Name)
+	if rulesFile == "" {
+		return errors.New("rules file is not given")
+	}
+	rules, err := external.LoadRules(rulesFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the rules: %v", err)
+	}
+
+	ks := keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)
+	passwords := utils.MakePasswordList(ctx)
+	for i, acc := range ks.Accounts() {
+		if err := ks.Unlock(acc, getPassword(passwords, i)); err != nil {
+			return fmt.Errorf("failed to unlock the account %v: %v", acc.Address.String(), err)
+		}
+		logger.Info("Unlocked the account", "address", acc.Address)
+	}
+
+	var approver external.Approver = rules
+	if ctx.GlobalBool(interactiveFlag.Name) {
+		approver = &terminalApprover{rules: rules, in: bufio.NewReader(os.Stdin)}
+	}
+	apis := []rpc.API{
+		{
+			Namespace: "account",
+			Version:   "1.0",
+			Service:   external.NewSignerAPI(ks, approver),
+			Public:    false,
+		},
+	}
+
+	var (
+		listener net.Listener
+		server   *rpc.Server
+	)
+	if ctx.GlobalBool(httpEnabledFlag.Name) {
+		endpoint := fmt.Sprintf("%s:%d", ctx.GlobalString(httpListenAddrFlag.Name), ctx.GlobalInt(httpPortFlag.Name))
+		listener, server, err = rpc.StartHTTPEndpoint(endpoint, apis, []string{"account"}, nil, []string{"localhost"})
+		if err != nil {
+			return err
+		}
+		logger.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint))
+	} else {
+		listener, server, err = rpc.StartIPCEndpoint(ctx.GlobalString(ipcPathFlag.Name), apis)
+		if err != nil {
+			return err
+		}
+		logger.Info("IPC endpoint opened", "url", ctx.GlobalString(ipcPathFlag.Name))
+	}
+	defer server.Stop()
+	defer listener.Close()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	<-sigc
+	logger.Info("Got interrupt, shutting down...")
+	return nil
+}
+
+// getPassword returns the password of the i-th account. The last password is used
+// if there are less passwords than the accounts.
+func getPassword(passwords []string, i int) string {
+	if len(passwords) == 0 {
+		return ""
+	}
+	if i < len(passwords) {
+		return passwords[i]
+	}
+	return passwords[len(passwords)-1]
+}
+
+// terminalApprover asks the operator to approve the requests satisfying the rules.
+type terminalApprover struct {
+	rules external.Rules
+	in    *bufio.Reader
+	mu    sync.Mutex
+}
+
+// Approve implements external.Approver.
+func (ta *terminalApprover) Approve(req *external.SignRequest) error {
+	if err := ta.rules.Approve(req); err != nil {
+		return err
+	}
+
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+
+	fmt.Printf("\n-------- %v request --------\n", req.Kind)
+	fmt.Printf("from:     %v\n", req.From.String())
+	if req.Tx != nil {
+		to := "<none>"
+		if req.Tx.To() != nil {
+			to = req.Tx.To().String()
+		}
+		fmt.Printf("type:     %v\n", req.Tx.Type())
+		fmt.Printf("to:       %v\n", to)
+		fmt.Printf("value:    %v\n", req.Tx.Value())
+		fmt.Printf("gas:      %v\n", req.Tx.Gas())
+		fmt.Printf("gasPrice: %v\n", req.Tx.GasPrice())
+		fmt.Printf("nonce:    %v\n", req.Tx.Nonce())
+		fmt.Printf("chainID:  %v\n", req.ChainID)
+	} else {
+		fmt.Printf("hash:     %v\n", hexutil.Encode(req.Hash))
+	}
+	fmt.Print("Approve? [y/N] ")
+
+	answer, err := ta.in.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if strings.ToLower(strings.TrimSpace(answer)) != "y" {
+		return external.ErrRequestRejected
+	}
+	return nil
+}
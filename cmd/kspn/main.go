@@ -59,6 +59,7 @@ var spnHelpFlagGroups = []utils.FlagGroup{
 			utils.DbTypeFlag,
 			utils.DataDirFlag,
 			utils.KeyStoreDirFlag,
+			utils.ExternalSignerFlag,
 			utils.IdentityFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -77,6 +78,9 @@ var spnHelpFlagGroups = []utils.FlagGroup{
 			utils.SubBridgeFlag,
 			utils.SubBridgeListenPortFlag,
 			utils.AnchoringPeriodFlag,
+			utils.AnchoringBatchFlag,
+			utils.ParentOperatorSignerFlag,
+			utils.ChildOperatorSignerFlag,
 			utils.SentChainTxsLimit,
 			utils.ParentChainIDFlag,
 			utils.VTRecoveryFlag,
@@ -119,6 +123,7 @@ var spnHelpFlagGroups = []utils.FlagGroup{
 			utils.LevelDBNoBufferPoolFlag,
 			utils.NoParallelDBWriteFlag,
 			utils.SenderTxHashIndexingFlag,
+			utils.AccountKeyHistoryIndexingFlag,
 		},
 	},
 	{
@@ -155,6 +160,9 @@ var spnHelpFlagGroups = []utils.FlagGroup{
 			utils.NoDiscoverFlag,
 			utils.RWTimerWaitTimeFlag,
 			utils.RWTimerIntervalFlag,
+			utils.RateLimitCNFlag,
+			utils.RateLimitPNFlag,
+			utils.RateLimitENFlag,
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
@@ -174,6 +182,7 @@ var spnHelpFlagGroups = []utils.FlagGroup{
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
 			utils.VMLogTargetFlag,
+			utils.ParallelTxExecutionFlag,
 		},
 	},
 	{
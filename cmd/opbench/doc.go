@@ -0,0 +1,30 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+opbench measures the CPU time taken by each opcode and precompiled contract on the current machine.
+
+It compiles contracts/computationcost/opcodeBench.sol, deploys the contracts into an in-memory state
+and calls the benchmark functions of OpCodeBenchmarkContract with a computation cost profiler.
+The report shows the average execution time of each opcode and precompiled contract with its
+computation cost, so that the computation cost table in params/computation_cost_params.go can be
+validated against the actual execution time.
+
+Usage:
+
+	opbench --solc solc --loop 10000 --funcs Add,Mul,Sstore
+*/
+package main
@@ -0,0 +1,210 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/blockchain/vm/runtime"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/compiler"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+var (
+	solFlag    = flag.String("sol", "contracts/computationcost/opcodeBench.sol", "Path to the opcode benchmark Solidity source")
+	solcFlag   = flag.String("solc", "", "Solidity compiler to use")
+	loopFlag   = flag.Int64("loop", 10000, "Loop count given to each benchmark function")
+	repeatFlag = flag.Int("repeat", 3, "Number of calls of each benchmark function")
+	funcsFlag  = flag.String("funcs", "", "Comma separated benchmark functions to run (default = all)")
+	jsonFlag   = flag.Bool("json", false, "Print the report in JSON")
+)
+
+const (
+	stopContractName  = "StopContract"
+	benchContractName = "OpCodeBenchmarkContract"
+)
+
+// skippedFuncs are the functions of the benchmark contract which need specific inputs.
+var skippedFuncs = map[string]bool{
+	"precompiledContractTest": true,
+	"testMap":                 true,
+}
+
+// report is a row of the benchmark report.
+type report struct {
+	Name            string        `json:"name"`
+	Count           uint64        `json:"count"`
+	ComputationCost uint64        `json:"computationCost"` // computation cost of an execution
+	AvgTime         time.Duration `json:"avgTime"`         // average execution time
+	TimePerCost     float64       `json:"timePerCost"`     // nanoseconds per computation cost
+}
+
+func main() {
+	flag.Parse()
+
+	contracts, err := compiler.CompileSolidity(*solcFlag, *solFlag)
+	if err != nil {
+		fatalf("Failed to build Solidity contract: %v", err)
+	}
+	stopContract, benchContract := findContract(contracts, stopContractName), findContract(contracts, benchContractName)
+	if stopContract == nil || benchContract == nil {
+		fatalf("Contracts %s and %s should be in %s", stopContractName, benchContractName, *solFlag)
+	}
+	abiJSON, _ := json.Marshal(benchContract.Info.AbiDefinition) // Flatten the compiler parse
+	benchABI, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	if err != nil {
+		fatalf("Failed to parse the ABI of %s: %v", benchContractName, err)
+	}
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()))
+	cfg := &runtime.Config{ChainConfig: params.AllGxhashProtocolChanges, State: statedb}
+
+	_, stopAddr, _, err := runtime.Create(common.FromHex(stopContract.Code), cfg)
+	if err != nil {
+		fatalf("Failed to deploy %s: %v", stopContractName, err)
+	}
+	_, benchAddr, _, err := runtime.Create(common.FromHex(benchContract.Code), cfg)
+	if err != nil {
+		fatalf("Failed to deploy %s: %v", benchContractName, err)
+	}
+
+	profiler := vm.NewComputationCostProfiler(true)
+	cfg.EVMConfig = vm.Config{Debug: true, Tracer: profiler}
+	for _, method := range selectMethods(benchABI) {
+		input, err := benchABI.Pack(method.Name, benchmarkArgs(method, stopAddr)...)
+		if err != nil {
+			fatalf("Failed to pack the input of %s: %v", method.Name, err)
+		}
+		for i := 0; i < *repeatFlag; i++ {
+			if _, _, err := runtime.Call(benchAddr, input, cfg); err != nil {
+				fmt.Fprintf(os.Stderr, "Benchmark %s failed: %v\n", method.Name, err)
+				break
+			}
+		}
+	}
+
+	printReport(makeReport(profiler.Result(0, false)))
+}
+
+func findContract(contracts map[string]*compiler.Contract, name string) *compiler.Contract {
+	for fullName, contract := range contracts {
+		if strings.HasSuffix(fullName, ":"+name) {
+			return contract
+		}
+	}
+	return nil
+}
+
+// selectMethods returns the benchmark functions to run, sorted by name.
+func selectMethods(benchABI abi.ABI) []abi.Method {
+	selected := make(map[string]bool)
+	if *funcsFlag != "" {
+		for _, name := range strings.Split(*funcsFlag, ",") {
+			selected[strings.TrimSpace(name)] = true
+		}
+	}
+	var methods []abi.Method
+	for name, method := range benchABI.Methods {
+		if (len(selected) > 0 && !selected[name]) || (len(selected) == 0 && skippedFuncs[name]) {
+			continue
+		}
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+	return methods
+}
+
+// benchmarkArgs returns the arguments of a benchmark function. The first argument is the loop count,
+// and the others are small numbers, 32-byte data or the address of StopContract.
+func benchmarkArgs(method abi.Method, stopAddr common.Address) []interface{} {
+	args := make([]interface{}, len(method.Inputs))
+	for i, input := range method.Inputs {
+		switch input.Type.T {
+		case abi.UintTy:
+			if i == 0 {
+				args[i] = big.NewInt(*loopFlag)
+			} else {
+				args[i] = big.NewInt(32)
+			}
+		case abi.AddressTy:
+			args[i] = stopAddr
+		case abi.BytesTy:
+			args[i] = make([]byte, 32)
+		default:
+			fatalf("Unsupported argument type %v of %s", input.Type, method.Name)
+		}
+	}
+	return args
+}
+
+func makeReport(result *vm.ComputationCostResult) []report {
+	var reports []report
+	add := func(name string, p *vm.ComputationCostProfile) {
+		if p.Count == 0 {
+			return
+		}
+		r := report{
+			Name:            name,
+			Count:           p.Count,
+			ComputationCost: p.ComputationCost / p.Count,
+			AvgTime:         p.Time / time.Duration(p.Count),
+		}
+		if p.ComputationCost > 0 {
+			r.TimePerCost = float64(p.Time.Nanoseconds()) / float64(p.ComputationCost)
+		}
+		reports = append(reports, r)
+	}
+	for op, p := range result.Opcodes {
+		add(op, p)
+	}
+	for addr, p := range result.PrecompiledContracts {
+		add(addr.Hex(), p)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Name < reports[j].Name })
+	return reports
+}
+
+func printReport(reports []report) {
+	if *jsonFlag {
+		out, _ := json.MarshalIndent(reports, "", "  ")
+		fmt.Println(string(out))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCOUNT\tCOMPUTATION COST\tAVG TIME\tNS/COST")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%v\t%.3f\n", r.Name, r.Count, r.ComputationCost, r.AvgTime, r.TimePerCost)
+	}
+	w.Flush()
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/klaytn/klaytn/networks/p2p/netutil"
 	"github.com/klaytn/klaytn/node"
 	"github.com/klaytn/klaytn/node/cn"
+	"github.com/klaytn/klaytn/node/feepayer"
 	"github.com/klaytn/klaytn/node/sc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
@@ -97,6 +98,10 @@ var (
 		Name:  "keystore",
 		Usage: "Directory for the keystore (default = inside the datadir)",
 	}
+	ExternalSignerFlag = cli.StringFlag{
+		Name:  "signer",
+		Usage: "IPC path or HTTP URL of an external signer (e.g. ksigner) holding the account keys",
+	}
 	// TODO-Klaytn-Bootnode: redefine networkid
 	NetworkIdFlag = cli.Uint64Flag{
 		Name:  "networkid",
@@ -259,6 +264,10 @@ var (
 		Name:  "sendertxhashindexing",
 		Usage: "Enables storing mapping information of senderTxHash to txHash",
 	}
+	AccountKeyHistoryIndexingFlag = cli.BoolFlag{
+		Name:  "accountkeyhistoryindexing",
+		Usage: "Enables storing the history of account key changes of each address",
+	}
 	ChildChainIndexingFlag = cli.BoolFlag{
 		Name:  "childchainindexing",
 		Usage: "Enables storing transaction hash of child chain transaction for fast access to child chain data",
@@ -309,6 +318,10 @@ var (
 		Usage: "Password file to use for non-interactive password input",
 		Value: "",
 	}
+	MnemonicFlag = cli.BoolFlag{
+		Name:  "mnemonic",
+		Usage: "Create an HD wallet from a new BIP-39 mnemonic instead of a single key",
+	}
 
 	VMEnableDebugFlag = cli.BoolFlag{
 		Name:  "vmdebug",
@@ -319,6 +332,10 @@ var (
 		Usage: "Set the output target of vmlog precompiled contract (0: no output, 1: file, 2: stdout, 3: both)",
 		Value: 0,
 	}
+	ParallelTxExecutionFlag = cli.BoolFlag{
+		Name:  "paralleltxexecution",
+		Usage: "Execute the transactions of a block in parallel when importing it",
+	}
 
 	// Logging and debug settings
 	MetricsEnabledFlag = cli.BoolFlag{
@@ -480,6 +497,18 @@ var (
 		Usage: "The period to make and send a chain transaction to the parent chain",
 		Value: 1,
 	}
+	AnchoringBatchFlag = cli.BoolFlag{
+		Name:  "chaintxbatch",
+		Usage: "Anchor all blocks of each chain tx period in one chain transaction with the merkle root of the block hashes",
+	}
+	ParentOperatorSignerFlag = cli.StringFlag{
+		Name:  "parentoperatorsigner",
+		Usage: "IPC path or HTTP URL of an external signer for the parent chain operator account",
+	}
+	ChildOperatorSignerFlag = cli.StringFlag{
+		Name:  "childoperatorsigner",
+		Usage: "IPC path or HTTP URL of an external signer for the child chain operator account",
+	}
 	SentChainTxsLimit = cli.Uint64Flag{
 		Name:  "chaintxlimit",
 		Usage: "Number of service chain transactions stored for resending",
@@ -495,6 +524,18 @@ var (
 		Usage: "Wait time the rw timer waits for message writing",
 		Value: 15 * time.Second,
 	}
+	RateLimitCNFlag = cli.StringFlag{
+		Name:  "ratelimit.cn",
+		Usage: "Inbound rate limits of each CN peer (comma separated bandwidth=<bytes/s>, msgrate=<msgs/s>, msgrate.<msg code>=<msgs/s>)",
+	}
+	RateLimitPNFlag = cli.StringFlag{
+		Name:  "ratelimit.pn",
+		Usage: "Inbound rate limits of each PN peer (comma separated bandwidth=<bytes/s>, msgrate=<msgs/s>, msgrate.<msg code>=<msgs/s>)",
+	}
+	RateLimitENFlag = cli.StringFlag{
+		Name:  "ratelimit.en",
+		Usage: "Inbound rate limits of each EN peer (comma separated bandwidth=<bytes/s>, msgrate=<msgs/s>, msgrate.<msg code>=<msgs/s>)",
+	}
 
 	// ATM the url is left to the user and deployment to
 	JSpathFlag = cli.StringFlag{
@@ -657,6 +698,19 @@ var (
 		Usage: "The maximum difference between current block and event block. 0 means off",
 		Value: 0,
 	}
+	// Fee payer service
+	FeePayerFlag = cli.BoolFlag{
+		Name:  "feepayer",
+		Usage: "Enable the fee payer service signing fee-delegated transactions",
+	}
+	FeePayerAccountFlag = cli.StringFlag{
+		Name:  "feepayer.account",
+		Usage: "Address of the fee payer account, which should be unlocked",
+	}
+	FeePayerPolicyFlag = cli.StringFlag{
+		Name:  "feepayer.policy",
+		Usage: "JSON file of the policy deciding which transactions are paid",
+	}
 
 	// TODO-Klaytn-Bootnode: Add bootnode's metric options
 	// TODO-Klaytn-Bootnode: Implements bootnode's RPC
@@ -919,6 +973,18 @@ func MakePasswordList(ctx *cli.Context) []string {
 	return lines
 }
 
+// setRateLimit sets the rate limit from the flag if it is set.
+func setRateLimit(ctx *cli.Context, flag cli.StringFlag, limit *p2p.RateLimit) {
+	if !ctx.GlobalIsSet(flag.Name) {
+		return
+	}
+	parsed, err := p2p.ParseRateLimit(ctx.GlobalString(flag.Name))
+	if err != nil {
+		log.Fatalf("Option %q: %v", flag.Name, err)
+	}
+	*limit = parsed
+}
+
 func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 	setNodeKey(ctx, cfg)
 	setNAT(ctx, cfg)
@@ -955,6 +1021,10 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 	cfg.RWTimerConfig.Interval = ctx.GlobalUint64(RWTimerIntervalFlag.Name)
 	cfg.RWTimerConfig.WaitTime = ctx.GlobalDuration(RWTimerWaitTimeFlag.Name)
 
+	setRateLimit(ctx, RateLimitCNFlag, &cfg.RateLimitConfig.CN)
+	setRateLimit(ctx, RateLimitPNFlag, &cfg.RateLimitConfig.PN)
+	setRateLimit(ctx, RateLimitENFlag, &cfg.RateLimitConfig.EN)
+
 	if netrestrict := ctx.GlobalString(NetrestrictFlag.Name); netrestrict != "" {
 		list, err := netutil.ParseNetlist(netrestrict)
 		if err != nil {
@@ -1003,6 +1073,9 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(LightKDFFlag.Name) {
 		cfg.UseLightweightKDF = ctx.GlobalBool(LightKDFFlag.Name)
 	}
+	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
+		cfg.ExternalSigner = ctx.GlobalString(ExternalSignerFlag.Name)
+	}
 }
 
 func setTxPool(ctx *cli.Context, cfg *blockchain.TxPoolConfig) {
@@ -1151,6 +1224,7 @@ func SetKlayConfig(ctx *cli.Context, stack *node.Node, cfg *cn.Config) {
 	}
 
 	cfg.SenderTxHashIndexing = ctx.GlobalIsSet(SenderTxHashIndexingFlag.Name)
+	cfg.AccountKeyHistoryIndexing = ctx.GlobalIsSet(AccountKeyHistoryIndexingFlag.Name)
 	cfg.ParallelDBWrite = !ctx.GlobalIsSet(NoParallelDBWriteFlag.Name)
 	cfg.StateDBCaching = ctx.GlobalIsSet(StateDBCachingFlag.Name)
 	cfg.TrieCacheLimit = ctx.GlobalInt(TrieCacheLimitFlag.Name)
@@ -1164,6 +1238,7 @@ func SetKlayConfig(ctx *cli.Context, stack *node.Node, cfg *cn.Config) {
 			logger.Warn("Incorrect vmlog value", "err", err)
 		}
 	}
+	cfg.ParallelTxExecution = ctx.GlobalIsSet(ParallelTxExecutionFlag.Name)
 
 	// Override any default configs for hard coded network.
 	// TODO-Klaytn-Bootnode: Discuss and add `baobab` test network's genesis block
@@ -1239,6 +1314,19 @@ func RegisterDBSyncerService(stack *node.Node, cfg *dbsyncer.DBConfig) {
 	}
 }
 
+// RegisterFeePayerService adds a fee payer service to the stack
+func RegisterFeePayerService(stack *node.Node, cfg *feepayer.FeePayerConfig) {
+	if cfg.EnabledFeePayer {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			feePayer, err := feepayer.NewFeePayer(ctx, cfg)
+			return feePayer, err
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the fee payer service: %v", err)
+		}
+	}
+}
+
 // SetupNetwork configures the system for either the main net or some test network.
 func SetupNetwork(ctx *cli.Context) {
 	// TODO(fjl): move target gas limit into config
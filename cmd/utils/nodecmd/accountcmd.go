@@ -23,6 +23,7 @@ package nodecmd
 import (
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/hdwallet"
 	"github.com/klaytn/klaytn/accounts/keystore"
 	"github.com/klaytn/klaytn/api/debug"
 	"github.com/klaytn/klaytn/cmd/utils"
@@ -30,6 +31,9 @@ import (
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/log"
 	"gopkg.in/urfave/cli.v1"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 )
 
 var (
@@ -86,12 +90,18 @@ Print a short summary of all accounts`,
 					utils.KeyStoreDirFlag,
 					utils.PasswordFileFlag,
 					utils.LightKDFFlag,
+					utils.MnemonicFlag,
 				},
 				Description: `
     klay account new
 
 Creates a new account and prints the address.
 
+With --mnemonic, a new BIP-39 mnemonic is generated and its seed is stored as an
+HD wallet under <KEYSTORE>/hdwallet. The mnemonic and the address of the first
+account (m/44'/8217'/0'/0/0) are printed. Write down the mnemonic; the account
+can be recovered from it with "account import-mnemonic".
+
 The account is saved in encrypted format, you are prompted for a passphrase.
 
 You must remember this passphrase to unlock your account in the future.
@@ -170,6 +180,31 @@ Note:
 As you can directly copy your encrypted accounts to another klay instance,
 this import mechanism is not needed when you transfer an account between
 nodes.
+`,
+			},
+			{
+				Name:   "import-mnemonic",
+				Usage:  "Import a BIP-39 mnemonic into a new HD wallet",
+				Action: utils.MigrateFlags(accountImportMnemonic),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.KeyStoreDirFlag,
+					utils.PasswordFileFlag,
+					utils.LightKDFFlag,
+				},
+				ArgsUsage: "<mnemonicFile>",
+				Description: `
+    klay account import-mnemonic <mnemonicfile>
+
+Imports the BIP-39 mnemonic in <mnemonicfile> into a new HD wallet and prints
+the address of the first account (m/44'/8217'/0'/0/0).
+
+The seed of the mnemonic is saved in encrypted format under <KEYSTORE>/hdwallet,
+you are prompted for a passphrase.
+
+For non-interactive use the passphrase can be specified with the -password flag:
+
+    klay account import-mnemonic [options] <mnemonicfile>
 `,
 			},
 		},
@@ -297,6 +332,17 @@ func accountCreate(ctx *cli.Context) error {
 
 	password := getPassPhrase("Your new account is locked with a password. Please give a password. Do not forget this password.", true, 0, utils.MakePasswordList(ctx))
 
+	if ctx.GlobalBool(utils.MnemonicFlag.Name) {
+		mnemonic, err := hdwallet.NewMnemonic()
+		if err != nil {
+			log.Fatalf("Failed to generate mnemonic: %v", err)
+		}
+		acct := importMnemonic(keydir, scryptN, scryptP, mnemonic, password)
+		fmt.Printf("Mnemonic: %s\n", mnemonic)
+		fmt.Printf("Address: {%x}\n", acct.Address)
+		return nil
+	}
+
 	address, err := keystore.StoreKey(keydir, password, scryptN, scryptP)
 
 	if err != nil {
@@ -351,3 +397,49 @@ func accountImport(ctx *cli.Context) error {
 	fmt.Printf("Address: {%x}\n", acct.Address)
 	return nil
 }
+
+// importMnemonic stores the seed of the mnemonic as an HD wallet in the keystore directory.
+func importMnemonic(keydir string, scryptN, scryptP int, mnemonic, password string) accounts.Account {
+	hub, err := hdwallet.NewHub(filepath.Join(keydir, hdwallet.DirName), scryptN, scryptP)
+	if err != nil {
+		log.Fatalf("Failed to load HD wallets: %v", err)
+	}
+	_, acct, err := hub.ImportMnemonic(mnemonic, password)
+	if err != nil {
+		log.Fatalf("Could not create the HD wallet: %v", err)
+	}
+	return acct
+}
+
+func accountImportMnemonic(ctx *cli.Context) error {
+	if glogger, err := debug.GetGlogger(); err == nil {
+		log.ChangeGlobalLogLevel(glogger, log.Lvl(log.LvlError))
+	}
+	mnemonicFile := ctx.Args().First()
+	if len(mnemonicFile) == 0 {
+		log.Fatalf("mnemonic file must be given as argument")
+	}
+	content, err := ioutil.ReadFile(mnemonicFile)
+	if err != nil {
+		log.Fatalf("Failed to read the mnemonic: %v", err)
+	}
+	mnemonic := strings.Join(strings.Fields(string(content)), " ")
+
+	cfg := klayConfig{Node: defaultNodeConfig()}
+	// Load config file.
+	if file := ctx.GlobalString(ConfigFileFlag.Name); file != "" {
+		if err := loadConfig(file, &cfg); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+	utils.SetNodeConfig(ctx, &cfg.Node)
+	scryptN, scryptP, keydir, err := cfg.Node.AccountConfig()
+	if err != nil {
+		log.Fatalf("Failed to read configuration: %v", err)
+	}
+
+	password := getPassPhrase("Your new account is locked with a password. Please give a password. Do not forget this password.", true, 0, utils.MakePasswordList(ctx))
+	acct := importMnemonic(keydir, scryptN, scryptP, mnemonic, password)
+	fmt.Printf("Address: {%x}\n", acct.Address)
+	return nil
+}
@@ -25,10 +25,12 @@ import (
 	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/datasync/dbsyncer"
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/node"
 	"github.com/klaytn/klaytn/node/cn"
+	"github.com/klaytn/klaytn/node/feepayer"
 	"github.com/klaytn/klaytn/node/sc"
 	"github.com/klaytn/klaytn/params"
 	"gopkg.in/urfave/cli.v1"
@@ -208,6 +210,27 @@ func makeDBSyncerConfig(ctx *cli.Context) dbsyncer.DBConfig {
 	return *cfg
 }
 
+func makeFeePayerConfig(ctx *cli.Context) feepayer.FeePayerConfig {
+	cfg := *feepayer.DefaultConfig
+
+	if ctx.GlobalBool(utils.FeePayerFlag.Name) {
+		cfg.EnabledFeePayer = true
+
+		if ctx.GlobalIsSet(utils.FeePayerAccountFlag.Name) {
+			cfg.FeePayer = common.HexToAddress(ctx.GlobalString(utils.FeePayerAccountFlag.Name))
+		} else {
+			logger.Crit("The fee payer account must be set !", "key", utils.FeePayerAccountFlag.Name)
+		}
+		if ctx.GlobalIsSet(utils.FeePayerPolicyFlag.Name) {
+			cfg.PolicyFile = ctx.GlobalString(utils.FeePayerPolicyFlag.Name)
+		} else {
+			logger.Crit("The fee payer policy must be set !", "key", utils.FeePayerPolicyFlag.Name)
+		}
+	}
+
+	return cfg
+}
+
 func makeServiceChainConfig(ctx *cli.Context) (config sc.SCConfig) {
 	cfg := sc.DefaultConfig
 
@@ -225,10 +248,13 @@ func makeServiceChainConfig(ctx *cli.Context) (config sc.SCConfig) {
 	cfg.Anchoring = ctx.GlobalBool(utils.ServiceChainAnchoringFlag.Name)
 	cfg.ChildChainIndexing = ctx.GlobalIsSet(utils.ChildChainIndexingFlag.Name)
 	cfg.AnchoringPeriod = ctx.GlobalUint64(utils.AnchoringPeriodFlag.Name)
+	cfg.AnchoringBatch = ctx.GlobalBool(utils.AnchoringBatchFlag.Name)
 	cfg.SentChainTxsLimit = ctx.GlobalUint64(utils.SentChainTxsLimit.Name)
 	cfg.ParentChainID = ctx.GlobalUint64(utils.ParentChainIDFlag.Name)
 	cfg.VTRecovery = ctx.GlobalBool(utils.VTRecoveryFlag.Name)
 	cfg.VTRecoveryInterval = ctx.GlobalUint64(utils.VTRecoveryIntervalFlag.Name)
+	cfg.ParentOperatorSigner = ctx.GlobalString(utils.ParentOperatorSignerFlag.Name)
+	cfg.ChildOperatorSigner = ctx.GlobalString(utils.ChildOperatorSignerFlag.Name)
 	cfg.ServiceChainConsensus = utils.ServiceChainConsensusFlag.Value
 
 	return cfg
@@ -262,6 +288,9 @@ func MakeFullNode(ctx *cli.Context) *node.Node {
 	dbfg := makeDBSyncerConfig(ctx)
 	utils.RegisterDBSyncerService(stack, &dbfg)
 
+	fpcfg := makeFeePayerConfig(ctx)
+	utils.RegisterFeePayerService(stack, &fpcfg)
+
 	return stack
 }
 
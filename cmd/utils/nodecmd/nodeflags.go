@@ -34,6 +34,7 @@ var CommonNodeFlags = []cli.Flag{
 	utils.DbTypeFlag,
 	utils.DataDirFlag,
 	utils.KeyStoreDirFlag,
+	utils.ExternalSignerFlag,
 	utils.TxPoolNoLocalsFlag,
 	utils.TxPoolJournalFlag,
 	utils.TxPoolJournalIntervalFlag,
@@ -55,6 +56,7 @@ var CommonNodeFlags = []cli.Flag{
 	utils.LevelDBCacheSizeFlag,
 	utils.NoParallelDBWriteFlag,
 	utils.SenderTxHashIndexingFlag,
+	utils.AccountKeyHistoryIndexingFlag,
 	utils.TrieMemoryCacheSizeFlag,
 	utils.TrieBlockIntervalFlag,
 	utils.CacheTypeFlag,
@@ -74,11 +76,15 @@ var CommonNodeFlags = []cli.Flag{
 	utils.NoDiscoverFlag,
 	utils.RWTimerWaitTimeFlag,
 	utils.RWTimerIntervalFlag,
+	utils.RateLimitCNFlag,
+	utils.RateLimitPNFlag,
+	utils.RateLimitENFlag,
 	utils.NetrestrictFlag,
 	utils.NodeKeyFileFlag,
 	utils.NodeKeyHexFlag,
 	utils.VMEnableDebugFlag,
 	utils.VMLogTargetFlag,
+	utils.ParallelTxExecutionFlag,
 	utils.NetworkIdFlag,
 	utils.RPCCORSDomainFlag,
 	utils.RPCVirtualHostsFlag,
@@ -147,6 +153,10 @@ var KENFlags = []cli.Flag{
 	utils.BulkInsertSizeFlag,
 	utils.EventModeFlag,
 	utils.MaxBlockDiffFlag,
+	// Fee payer service
+	utils.FeePayerFlag,
+	utils.FeePayerAccountFlag,
+	utils.FeePayerPolicyFlag,
 	utils.TxResendIntervalFlag,
 	utils.TxResendCountFlag,
 	utils.TxResendUseLegacyFlag,
@@ -155,6 +165,9 @@ var KENFlags = []cli.Flag{
 var KSCNFlags = []cli.Flag{
 	utils.ServiceChainSignerFlag,
 	utils.AnchoringPeriodFlag,
+	utils.AnchoringBatchFlag,
+	utils.ParentOperatorSignerFlag,
+	utils.ChildOperatorSignerFlag,
 	utils.SentChainTxsLimit,
 	utils.MainBridgeFlag,
 	utils.MainBridgeListenPortFlag,
@@ -174,6 +187,9 @@ var KSPNFlags = []cli.Flag{
 	utils.TxResendUseLegacyFlag,
 	utils.ServiceChainSignerFlag,
 	utils.AnchoringPeriodFlag,
+	utils.AnchoringBatchFlag,
+	utils.ParentOperatorSignerFlag,
+	utils.ChildOperatorSignerFlag,
 	utils.SentChainTxsLimit,
 	utils.MainBridgeFlag,
 	utils.MainBridgeListenPortFlag,
@@ -195,6 +211,9 @@ var KSENFlags = []cli.Flag{
 	utils.SubBridgeFlag,
 	utils.SubBridgeListenPortFlag,
 	utils.AnchoringPeriodFlag,
+	utils.AnchoringBatchFlag,
+	utils.ParentOperatorSignerFlag,
+	utils.ChildOperatorSignerFlag,
 	utils.SentChainTxsLimit,
 	utils.ParentChainIDFlag,
 	utils.VTRecoveryFlag,
@@ -218,6 +237,10 @@ var KSENFlags = []cli.Flag{
 	utils.BulkInsertSizeFlag,
 	utils.EventModeFlag,
 	utils.MaxBlockDiffFlag,
+	// Fee payer service
+	utils.FeePayerFlag,
+	utils.FeePayerAccountFlag,
+	utils.FeePayerPolicyFlag,
 	utils.TxResendIntervalFlag,
 	utils.TxResendCountFlag,
 	utils.TxResendUseLegacyFlag,
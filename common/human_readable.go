@@ -0,0 +1,91 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+const (
+	// HumanReadableSuffix is the suffix of every human-readable address.
+	HumanReadableSuffix = ".klaytn"
+
+	// MinHumanReadableNameLength and MaxHumanReadableNameLength are the length limits
+	// of the name of a human-readable address excluding the suffix.
+	MinHumanReadableNameLength = 5
+	MaxHumanReadableNameLength = AddressLength - len(HumanReadableSuffix)
+)
+
+var (
+	ErrHumanReadableNoSuffix     = errors.New("the human-readable address does not end with " + HumanReadableSuffix)
+	ErrHumanReadableNameTooShort = errors.New("the name of the human-readable address is too short")
+	ErrHumanReadableNameTooLong  = errors.New("the name of the human-readable address is too long")
+	ErrHumanReadableNameInvalid  = errors.New("the name of the human-readable address should start with a lowercase letter followed by lowercase letters or digits")
+	ErrInvalidHumanReadableAddr  = errors.New("the address does not represent a human-readable address")
+)
+
+// HumanReadableToAddress returns the address of the human-readable address like "colin.klaytn".
+// The address is the ASCII encoding of the human-readable address padded with zeros on the left,
+// so the human-readable address can be restored from the address.
+func HumanReadableToAddress(hra string) (Address, error) {
+	if err := ValidateHumanReadable(hra); err != nil {
+		return Address{}, err
+	}
+	return BytesToAddress([]byte(hra)), nil
+}
+
+// AddressToHumanReadable returns the human-readable address represented by the address.
+// It returns an error if the address is not the encoding of a valid human-readable address.
+func AddressToHumanReadable(addr Address) (string, error) {
+	hra := string(bytes.TrimLeft(addr[:], "\x00"))
+	if ValidateHumanReadable(hra) != nil {
+		return "", ErrInvalidHumanReadableAddr
+	}
+	return hra, nil
+}
+
+// IsHumanReadableAddress returns true if the address is the encoding of a valid human-readable address.
+func IsHumanReadableAddress(addr Address) bool {
+	_, err := AddressToHumanReadable(addr)
+	return err == nil
+}
+
+// ValidateHumanReadable checks if the human-readable address consists of a name of 5 to 13
+// characters and the suffix. The name should start with a lowercase letter, and the other
+// characters should be lowercase letters or digits.
+func ValidateHumanReadable(hra string) error {
+	if !strings.HasSuffix(hra, HumanReadableSuffix) {
+		return ErrHumanReadableNoSuffix
+	}
+	name := strings.TrimSuffix(hra, HumanReadableSuffix)
+	if len(name) < MinHumanReadableNameLength {
+		return ErrHumanReadableNameTooShort
+	}
+	if len(name) > MaxHumanReadableNameLength {
+		return ErrHumanReadableNameTooLong
+	}
+	for i, c := range name {
+		isLetter := 'a' <= c && c <= 'z'
+		isDigit := '0' <= c && c <= '9'
+		if !isLetter && (i == 0 || !isDigit) {
+			return ErrHumanReadableNameInvalid
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import "testing"
+
+func TestHumanReadableToAddress(t *testing.T) {
+	tests := []struct {
+		hra string
+		err error
+	}{
+		{"colin.klaytn", nil},
+		{"a1234.klaytn", nil},
+		{"abcdefghijklm.klaytn", nil},
+		{"colin", ErrHumanReadableNoSuffix},
+		{"colin.klay", ErrHumanReadableNoSuffix},
+		{"colin.klaytn.klaytn", ErrHumanReadableNameInvalid},
+		{"abcd.klaytn", ErrHumanReadableNameTooShort},
+		{".klaytn", ErrHumanReadableNameTooShort},
+		{"abcdefghijklmn.klaytn", ErrHumanReadableNameTooLong},
+		{"Colin.klaytn", ErrHumanReadableNameInvalid},
+		{"1colin.klaytn", ErrHumanReadableNameInvalid},
+		{"col-in.klaytn", ErrHumanReadableNameInvalid},
+		{"col.in.klaytn", ErrHumanReadableNameInvalid},
+		{"colin\x00.klaytn", ErrHumanReadableNameInvalid},
+	}
+
+	for _, test := range tests {
+		addr, err := HumanReadableToAddress(test.hra)
+		if err != test.err {
+			t.Errorf("HumanReadableToAddress(%q) error = %v; expected %v", test.hra, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		hra, err := AddressToHumanReadable(addr)
+		if err != nil || hra != test.hra {
+			t.Errorf("AddressToHumanReadable(%s) = (%q, %v); expected %q", addr.Hex(), hra, err, test.hra)
+		}
+	}
+}
+
+func TestAddressToHumanReadable(t *testing.T) {
+	addr, _ := HumanReadableToAddress("colin.klaytn")
+	if exp := HexToAddress("0x0000000000000000636f6c696e2e6b6c6179746e"); addr != exp {
+		t.Errorf("HumanReadableToAddress(colin.klaytn) = %s; expected %s", addr.Hex(), exp.Hex())
+	}
+
+	// The addresses which do not encode valid human-readable addresses.
+	addrs := []Address{
+		{},
+		HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		BytesToAddress([]byte("Colin.klaytn")),
+		BytesToAddress([]byte("colin.klaytn\x00")),
+		BytesToAddress([]byte("co\x00in.klaytn")),
+	}
+	for _, addr := range addrs {
+		if _, err := AddressToHumanReadable(addr); err != ErrInvalidHumanReadableAddr {
+			t.Errorf("AddressToHumanReadable(%s) error = %v; expected %v", addr.Hex(), err, ErrInvalidHumanReadableAddr)
+		}
+		if IsHumanReadableAddress(addr) {
+			t.Errorf("IsHumanReadableAddress(%s) = true; expected false", addr.Hex())
+		}
+	}
+}
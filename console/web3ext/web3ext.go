@@ -35,8 +35,33 @@ var Modules = map[string]string{
 	"clique":     CliqueJs,
 	"governance": Governance_JS,
 	"bootnode":   Bootnode_JS,
+	"feepayer":   FeePayer_JS,
 }
 
+const FeePayer_JS = `
+web3._extend({
+	property: 'feepayer',
+	methods: [
+		new web3._extend.Method({
+			name: 'signAndSend',
+			call: 'feepayer_signAndSend',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getUsage',
+			call: 'feepayer_getUsage',
+			params: 1
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'account',
+			getter: 'feepayer_account'
+		}),
+	]
+});
+`
+
 const Bootnode_JS = `
 web3._extend({
 	property: 'bootnode',
@@ -108,6 +133,26 @@ web3._extend({
 			name: 'deleteAuthorizedNodes',
 			call: 'bootnode_deleteAuthorizedNodes',
 			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'listAuthorizedNodes',
+			call: 'bootnode_listAuthorizedNodes',
+			params: 0
+		}),
+		new web3._extend.Method({
+			name: 'putAuthorizedNodesWithTTL',
+			call: 'bootnode_putAuthorizedNodesWithTTL',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'importAuthorizedNodes',
+			call: 'bootnode_importAuthorizedNodes',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getAuthorizedNodeAudits',
+			call: 'bootnode_getAuthorizedNodeAudits',
+			params: 1
 		})
 	],
 	properties: []
@@ -193,6 +238,16 @@ web3._extend({
 			call: 'admin_removePeer',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'banPeer',
+			call: 'admin_banPeer',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'unbanPeer',
+			call: 'admin_unbanPeer',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'exportChain',
 			call: 'admin_exportChain',
@@ -239,6 +294,10 @@ web3._extend({
 			name: 'peers',
 			getter: 'admin_peers'
 		}),
+		new web3._extend.Property({
+			name: 'bannedPeers',
+			getter: 'admin_bannedPeers'
+		}),
 		new web3._extend.Property({
 			name: 'datadir',
 			getter: 'admin_datadir'
@@ -471,6 +530,18 @@ web3._extend({
 			params: 2,
 			inputFormatter: [null, null]
 		}),
+		new web3._extend.Method({
+			name: 'profileComputationCost',
+			call: 'debug_profileComputationCost',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'profileComputationCostCall',
+			call: 'debug_profileComputationCostCall',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputCallFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -551,6 +622,21 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputTransactionFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'newTxEnvelope',
+			call: 'klay_newTxEnvelope',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'inspectTxEnvelope',
+			call: 'klay_inspectTxEnvelope',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'sendTxEnvelope',
+			call: 'klay_sendTxEnvelope',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'getCouncil',
 			call: 'klay_getCouncil',
@@ -612,6 +698,36 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'isHumanReadable',
+			call: 'klay_isHumanReadable',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'resolveHumanReadable',
+			call: 'klay_resolveHumanReadable',
+			params: 2,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getHumanReadable',
+			call: 'klay_getHumanReadable',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'validateSignatures',
+			call: 'klay_validateSignatures',
+			params: 5,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null, null, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getAccountKeyHistory',
+			call: 'klay_getAccountKeyHistory',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'submitTransaction',
 			call: 'klay_submitTransaction',
@@ -785,6 +901,16 @@ web3._extend({
 			call: 'personal_replaceRawKey',
 			params: 3
 		}),
+		new web3._extend.Method({
+			name: 'importRawKeys',
+			call: 'personal_importRawKeys',
+			params: 3
+		}),
+		new web3._extend.Method({
+			name: 'replaceRawKeys',
+			call: 'personal_replaceRawKeys',
+			params: 4
+		}),
 		new web3._extend.Method({
 			name: 'sign',
 			call: 'personal_sign',
@@ -824,6 +950,16 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputTransactionFormatter, null]
 		}),
+		new web3._extend.Method({
+			name: 'signTxEnvelope',
+			call: 'personal_signTxEnvelope',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'signTxEnvelopeAsFeePayer',
+			call: 'personal_signTxEnvelopeAsFeePayer',
+			params: 2
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -930,6 +1066,11 @@ web3._extend({
 			call: 'mainbridge_convertChildChainBlockHashToParentChainTxHash',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'verifyAnchoringProof',
+			call: 'mainbridge_verifyAnchoringProof',
+			params: 2
+		}),
 	],
     properties: [
 		new web3._extend.Property({
@@ -963,6 +1104,11 @@ web3._extend({
 			call: 'subbridge_getReceiptFromParentChain',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getAnchoringProof',
+			call: 'subbridge_getAnchoringProof',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'deployBridge',
 			call: 'subbridge_deployBridge',
@@ -1008,6 +1154,26 @@ web3._extend({
 			call: 'subbridge_convertRequestTxHashToHandleTxHash',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getValueTransferStatus',
+			call: 'subbridge_getValueTransferStatus',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getValueTransferStatusesBySender',
+			call: 'subbridge_getValueTransferStatusesBySender',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getValueTransferStatusesByToken',
+			call: 'subbridge_getValueTransferStatusesByToken',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getValueTransferStatusesByStatus',
+			call: 'subbridge_getValueTransferStatusesByStatus',
+			params: 2
+		}),
 		new web3._extend.Method({
 			name: 'getBridgeInformation',
 			call: 'subbridge_getBridgeInformation',
@@ -1028,6 +1194,11 @@ web3._extend({
 			call: 'subbridge_setERC20Fee',
 			params: 3
 		}),
+		new web3._extend.Method({
+			name: 'setERC1155Fee',
+			call: 'subbridge_setERC1155Fee',
+			params: 3
+		}),
 		new web3._extend.Method({
 			name: 'setFeeReceiver',
 			call: 'subbridge_setFeeReceiver',
@@ -1043,6 +1214,11 @@ web3._extend({
 			call: 'subbridge_getERC20Fee',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'getERC1155Fee',
+			call: 'subbridge_getERC1155Fee',
+			params: 2
+		}),
 		new web3._extend.Method({
 			name: 'getFeeReceiver',
 			call: 'subbridge_getFeeReceiver',
@@ -19,10 +19,12 @@ pragma solidity ^0.5.6;
 import "./BridgeTransferKLAY.sol";
 import "./BridgeTransferERC20.sol";
 import "./BridgeTransferERC721.sol";
+import "./BridgeTransferERC1155.sol";
+import "./BridgeTransferMessage.sol";
 import "./BridgeCounterPart.sol";
 
 
-contract Bridge is BridgeCounterPart, BridgeTransferKLAY, BridgeTransferERC20, BridgeTransferERC721 {
+contract Bridge is BridgeCounterPart, BridgeTransferKLAY, BridgeTransferERC20, BridgeTransferERC721, BridgeTransferERC1155, BridgeTransferMessage {
     uint64 public constant VERSION = 1;
 
     constructor(bool _modeMintBurn) BridgeTransfer(_modeMintBurn) public payable {
@@ -27,9 +27,11 @@ contract BridgeFee {
     address payable public feeReceiver = address(0);
     uint256 public feeOfKLAY = 0;
     mapping (address => uint256) public feeOfERC20;
+    mapping (address => uint256) public feeOfERC1155;
 
     event KLAYFeeChanged(uint256 indexed fee);
     event ERC20FeeChanged(address token, uint256 indexed fee);
+    event ERC1155FeeChanged(address token, uint256 indexed fee);
     event FeeReceiverChanged(address indexed feeReceiver);
 
     constructor(address payable _feeReceiver) internal {
@@ -68,6 +70,24 @@ contract BridgeFee {
         return 0;
     }
 
+    // _payERC1155FeeAndRefundChange pays the ERC1155 transfer fee with KLAY and refunds the change to _from.
+    function _payERC1155FeeAndRefundChange(address _from, address _token, uint256 _feeLimit) internal returns(uint256) {
+        uint256 fee = feeOfERC1155[_token];
+        address payable from = address(uint160(_from));
+
+        if (feeReceiver != address(0) && fee > 0) {
+            require(_feeLimit >= fee, "insufficient feeLimit");
+
+            feeReceiver.transfer(fee);
+            from.transfer(_feeLimit.sub(fee));
+
+            return fee;
+        }
+
+        from.transfer(_feeLimit);
+        return 0;
+    }
+
     function _setKLAYFee(uint256 _fee) internal {
         feeOfKLAY = _fee;
         emit KLAYFeeChanged(_fee);
@@ -78,6 +98,11 @@ contract BridgeFee {
         emit ERC20FeeChanged(_token, _fee);
     }
 
+    function _setERC1155Fee(address _token, uint256 _fee) internal {
+        feeOfERC1155[_token] = _fee;
+        emit ERC1155FeeChanged(_token, _fee);
+    }
+
     function _setFeeReceiver(address payable _to) internal {
         feeReceiver = _to;
         emit FeeReceiverChanged(_to);
@@ -41,7 +41,9 @@ contract BridgeTransfer is BridgeHandledRequests, BridgeFee, BridgeOperator {
     enum TokenType {
         KLAY,
         ERC20,
-        ERC721
+        ERC721,
+        ERC1155,
+        MESSAGE
     }
 
     constructor(bool _modeMintBurn) BridgeFee(address(0)) internal {
@@ -90,15 +92,15 @@ contract BridgeTransfer is BridgeHandledRequests, BridgeFee, BridgeOperator {
 
     /**
      * Event to log the request value transfer from the Bridge.
-     * @param tokenType is the type of tokens (KLAY/ERC20/ERC721).
+     * @param tokenType is the type of tokens (KLAY/ERC20/ERC721/ERC1155) or MESSAGE.
      * @param from is the requester of the request value transfer event.
-     * @param to is the receiver of the value.
+     * @param to is the receiver of the value or the target contract of the message.
      * @param tokenAddress Address of token contract the token belong to.
-     * @param valueOrTokenId is the value of KLAY/ERC20 or token ID of ERC721.
+     * @param valueOrTokenId is the value of KLAY/ERC20, token ID of ERC721/ERC1155 or the gas limit of MESSAGE.
      * @param requestNonce is the order number of the request value transfer.
      * @param uri is uri of ERC721 token.
      * @param fee is fee of value transfer.
-     * @param extraData is additional data for specific purpose of a service provider.
+     * @param extraData is additional data for specific purpose of a service provider or the message of MESSAGE.
      */
     event RequestValueTransfer(
         TokenType tokenType,
@@ -112,16 +114,40 @@ contract BridgeTransfer is BridgeHandledRequests, BridgeFee, BridgeOperator {
         bytes extraData
     );
 
+    /**
+     * Event to log the request ERC1155 transfer from the Bridge.
+     * @param from is the requester of the request value transfer event.
+     * @param to is the receiver of the tokens.
+     * @param tokenAddress Address of token contract the token belong to.
+     * @param tokenId is the token ID of ERC1155.
+     * @param amount is the amount of the ERC1155 tokens.
+     * @param requestNonce is the order number of the request value transfer.
+     * @param uri is uri of ERC1155 token.
+     * @param fee is fee of value transfer.
+     * @param extraData is additional data for specific purpose of a service provider.
+     */
+    event RequestERC1155Transfer(
+        address from,
+        address to,
+        address tokenAddress,
+        uint256 tokenId,
+        uint256 amount,
+        uint64 requestNonce,
+        string uri,
+        uint256 fee,
+        bytes extraData
+    );
+
     /**
      * Event to log the handle value transfer from the Bridge.
      * @param requestTxHash is a transaction hash of request value transfer.
-     * @param tokenType is the type of tokens (KLAY/ERC20/ERC721).
+     * @param tokenType is the type of tokens (KLAY/ERC20/ERC721/ERC1155) or MESSAGE.
      * @param from is an address of the account who requested the value transfer.
      * @param to is an address of the account who will received the value.
      * @param tokenAddress Address of token contract the token belong to.
-     * @param valueOrTokenId is the value of KLAY/ERC20 or token ID of ERC721.
+     * @param valueOrTokenId is the value of KLAY/ERC20, token ID of ERC721/ERC1155 or the gas limit of MESSAGE.
      * @param handleNonce is the order number of the handle value transfer.
-     * @param extraData is additional data for specific purpose of a service provider.
+     * @param extraData is additional data for specific purpose of a service provider or the message of MESSAGE.
      */
     event HandleValueTransfer(
         bytes32 requestTxHash,
@@ -0,0 +1,184 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../sc_erc1155/IERC1155.sol";
+import "../sc_erc1155/IERC1155Receiver.sol";
+import "../sc_erc1155/IERC1155MetadataURI.sol";
+import "../sc_erc1155/IERC1155Mintable.sol";
+import "../sc_erc1155/IERC1155Burnable.sol";
+
+import "../sc_erc1155/IERC1155BridgeReceiver.sol";
+import "./BridgeTransferCommon.sol";
+
+
+contract BridgeTransferERC1155 is IERC1155BridgeReceiver, IERC1155Receiver, BridgeTransfer {
+    // Equals to `bytes4(keccak256("onERC1155Received(address,address,uint256,uint256,bytes)"))`
+    bytes4 private constant _ERC1155_RECEIVED = 0xf23a6e61;
+
+    // handleERC1155Transfer sends the ERC1155 by the request.
+    function handleERC1155Transfer(
+        bytes32 _requestTxHash,
+        address _from,
+        address _to,
+        address _tokenAddress,
+        uint256 _tokenId,
+        uint256 _amount,
+        uint64 _requestedNonce,
+        uint64 _requestedBlockNumber,
+        string memory _tokenURI,
+        bytes memory _extraData
+    )
+        public
+        onlyOperators
+    {
+        lowerHandleNonceCheck(_requestedNonce);
+
+        if (!voteValueTransfer(_requestedNonce)) {
+            return;
+        }
+
+        emit HandleValueTransfer(
+            _requestTxHash,
+            TokenType.ERC1155,
+            _from,
+            _to,
+            _tokenAddress,
+            _tokenId,
+            _requestedNonce,
+            _extraData
+        );
+        _setHandledRequestTxHash(_requestTxHash);
+
+        updateHandleNonce(_requestedNonce, _requestedBlockNumber);
+
+        if (modeMintBurn) {
+            IERC1155Mintable(_tokenAddress).mintWithURI(_to, _tokenId, _amount, _tokenURI);
+        } else {
+            IERC1155(_tokenAddress).safeTransferFrom(address(this), _to, _tokenId, _amount, "");
+        }
+    }
+
+    // _requestERC1155Transfer requests transfer ERC1155 to _to on relative chain.
+    function _requestERC1155Transfer(
+        address _tokenAddress,
+        address _from,
+        address _to,
+        uint256 _tokenId,
+        uint256 _amount,
+        uint256 _feeLimit,
+        bytes memory _extraData
+    )
+        internal
+    {
+        require(isRunning, "stopped bridge");
+        require(_amount > 0, "zero amount");
+        require(allowedTokens[_tokenAddress] != address(0), "invalid token");
+
+        uint256 fee = _payERC1155FeeAndRefundChange(_from, _tokenAddress, _feeLimit);
+        string memory uri = IERC1155MetadataURI(_tokenAddress).uri(_tokenId);
+
+        if (modeMintBurn) {
+            IERC1155Burnable(_tokenAddress).burn(address(this), _tokenId, _amount);
+        }
+
+        emit RequestERC1155Transfer(
+            _from,
+            _to,
+            _tokenAddress,
+            _tokenId,
+            _amount,
+            requestNonce,
+            uri,
+            fee,
+            _extraData
+        );
+        requestNonce++;
+    }
+
+    // onERC1155Received accepts the ERC1155 tokens of the registered token contracts.
+    function onERC1155Received(
+        address,
+        address,
+        uint256,
+        uint256,
+        bytes memory
+    )
+        public
+        returns (bytes4)
+    {
+        require(allowedTokens[msg.sender] != address(0), "invalid token");
+        return _ERC1155_RECEIVED;
+    }
+
+    // onERC1155BatchReceived rejects the batch transfer since a value transfer request handles a single token ID.
+    function onERC1155BatchReceived(
+        address,
+        address,
+        uint256[] memory,
+        uint256[] memory,
+        bytes memory
+    )
+        public
+        returns (bytes4)
+    {
+        revert("batch transfer is not supported");
+    }
+
+    // onERC1155BridgeReceived function of ERC1155 token for 1-step deposits to the Bridge.
+    // The sent KLAY is used as the fee limit of the request.
+    function onERC1155BridgeReceived(
+        address _from,
+        uint256 _tokenId,
+        uint256 _amount,
+        address _to,
+        bytes memory _extraData
+    )
+        public
+        payable
+    {
+        _requestERC1155Transfer(msg.sender, _from, _to, _tokenId, _amount, msg.value, _extraData);
+    }
+
+    // requestERC1155Transfer requests transfer ERC1155 to _to on relative chain.
+    // The sent KLAY is used as the fee limit of the request.
+    function requestERC1155Transfer(
+        address _tokenAddress,
+        address _to,
+        uint256 _tokenId,
+        uint256 _amount,
+        bytes memory _extraData
+    )
+        public
+        payable
+    {
+        IERC1155(_tokenAddress).safeTransferFrom(msg.sender, address(this), _tokenId, _amount, "");
+        _requestERC1155Transfer(_tokenAddress, msg.sender, _to, _tokenId, _amount, msg.value, _extraData);
+    }
+
+    // setERC1155Fee sets the fee of the ERC1155 token transfer.
+    function setERC1155Fee(address _token, uint256 _fee, uint64 _requestNonce)
+        external
+        onlyOperators
+    {
+        if (!voteConfiguration(_requestNonce)) {
+            return;
+        }
+        _setERC1155Fee(_token, _fee);
+    }
+}
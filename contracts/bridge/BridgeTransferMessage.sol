@@ -0,0 +1,116 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../externals/openzeppelin-solidity/contracts/utils/Address.sol";
+
+import "./IBridgeMessageReceiver.sol";
+import "./BridgeTransferCommon.sol";
+
+
+contract BridgeTransferMessage is BridgeTransfer {
+    using Address for address;
+
+    // The gas limit of a message is capped so that a handle transaction of the operators
+    // (DefaultBridgeTxGasLimit of the sub-bridge) can always forward it to the target contract.
+    uint64 public constant MAX_MESSAGE_GAS_LIMIT = 3000000;
+
+    /**
+     * Event to log the failed delivery of the message transfer.
+     * @param requestTxHash is a transaction hash of request message transfer.
+     * @param to is the target contract of the message.
+     * @param handleNonce is the order number of the handle message transfer.
+     */
+    event MessageTransferFailed(bytes32 requestTxHash, address to, uint64 handleNonce);
+
+    // handleMessageTransfer delivers the message to the target contract by the request.
+    // The target contract is called with the gas limit given by the requester.
+    function handleMessageTransfer(
+        bytes32 _requestTxHash,
+        address _from,
+        address _to,
+        uint64 _gasLimit,
+        uint64 _requestedNonce,
+        uint64 _requestedBlockNumber,
+        bytes memory _message
+    )
+        public
+        onlyOperators
+    {
+        lowerHandleNonceCheck(_requestedNonce);
+
+        if (!voteValueTransfer(_requestedNonce)) {
+            return;
+        }
+
+        emit HandleValueTransfer(
+            _requestTxHash,
+            TokenType.MESSAGE,
+            _from,
+            _to,
+            address(0),
+            _gasLimit,
+            _requestedNonce,
+            _message
+        );
+        _setHandledRequestTxHash(_requestTxHash);
+
+        updateHandleNonce(_requestedNonce, _requestedBlockNumber);
+
+        // A failure of the target contract does not revert the handling
+        // so that the following requests are not blocked by the message.
+        bool success = false;
+        if (_to.isContract()) {
+            // Only 63/64 of the remaining gas can be forwarded, so the handling is reverted
+            // rather than failing the message by an operator which sent too little gas.
+            require(gasleft() - gasleft() / 64 > _gasLimit, "insufficient gas for message");
+            (success, ) = _to.call.gas(_gasLimit)(abi.encodeWithSelector(
+                IBridgeMessageReceiver(_to).onBridgeMessageReceived.selector,
+                _from,
+                _message
+            ));
+        }
+        if (!success) {
+            emit MessageTransferFailed(_requestTxHash, _to, _requestedNonce);
+        }
+    }
+
+    // requestMessageTransfer requests to deliver the message to the _to contract on relative chain.
+    // The _to contract is called with at most _gasLimit gas, which is logged as valueOrTokenId.
+    // The sent KLAY is used as the fee limit of the request and the KLAY transfer fee is charged.
+    function requestMessageTransfer(address _to, uint64 _gasLimit, bytes calldata _message) external payable {
+        require(isRunning, "stopped bridge");
+        require(_to != address(0), "zero address");
+        require(_gasLimit <= MAX_MESSAGE_GAS_LIMIT, "too large gas limit");
+
+        uint256 fee = _payKLAYFeeAndRefundChange(msg.value);
+
+        emit RequestValueTransfer(
+            TokenType.MESSAGE,
+            msg.sender,
+            _to,
+            address(0),
+            _gasLimit,
+            requestNonce,
+            "",
+            fee,
+            _message
+        );
+        requestNonce++;
+    }
+}
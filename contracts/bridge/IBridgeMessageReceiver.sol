@@ -0,0 +1,28 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+/**
+ * @title IBridgeMessageReceiver
+ * @dev Interface for a contract that receives the messages delivered by the Bridge.
+ * The receiver should check if msg.sender is the trusted bridge because _from is
+ * the requester on the counterpart chain.
+ */
+contract IBridgeMessageReceiver {
+    function onBridgeMessageReceived(address _from, bytes memory _message) public;
+}
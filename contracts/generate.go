@@ -21,6 +21,7 @@ package contracts
 
 //go:generate abigen --sol ./sc_erc721/sc_nft.sol --pkg scnft --out ./sc_erc721/sc_nft.go
 //go:generate abigen --sol ./sc_erc20/sc_token.sol --pkg sctoken --out ./sc_erc20/sc_token.go
+//go:generate abigen --sol ./sc_erc1155/sc_multitoken.sol --pkg scmultitoken --out ./sc_erc1155/sc_multitoken.go
 
 //`credit.sol` was compiled by solidity@0.4.24.
 // This code data was included in cypress genesis file.
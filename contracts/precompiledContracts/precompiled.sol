@@ -126,3 +126,34 @@ contract PrecompiledBn256Pairing {
         }
     }
 }
+
+contract PrecompiledValidateAccountKey {
+    // Role types of an account key.
+    uint8 constant ROLE_TRANSACTION = 0;
+    uint8 constant ROLE_ACCOUNT_UPDATE = 1;
+    uint8 constant ROLE_FEE_PAYER = 2;
+
+    // callValidateAccountKey returns true if the signatures of msgHash satisfy the account key of
+    // the account for the role. sigs is a concatenation of 65-byte signatures in the [R || S || V] format.
+    function callValidateAccountKey(address account, uint8 role, bytes32 msgHash, bytes memory sigs) public view returns (bool result) {
+        require(sigs.length % 65 == 0);
+        // input is a serialized bytes stream of (account, role, msgHash, sig1, sig2, ...)
+        bytes memory input = abi.encodePacked(account, role, msgHash, sigs);
+        assembly {
+            let memPtr := mload(0x40)
+            let success := staticcall(gas, 0x0d, add(input, 0x20), mload(input), memPtr, 0x20)
+            switch success
+            case 0 {
+                revert(0,0)
+            } default {
+                result := mload(memPtr)
+            }
+        }
+    }
+
+    // callValidateSignature returns true if a single signature of msgHash satisfies the account key
+    // of the account for the role.
+    function callValidateSignature(address account, uint8 role, bytes32 msgHash, uint8 v, bytes32 r, bytes32 s) public view returns (bool) {
+        return callValidateAccountKey(account, role, msgHash, abi.encodePacked(r, s, v));
+    }
+}
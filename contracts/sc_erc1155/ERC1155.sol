@@ -0,0 +1,194 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../externals/openzeppelin-solidity/contracts/introspection/ERC165.sol";
+import "../externals/openzeppelin-solidity/contracts/math/SafeMath.sol";
+import "../externals/openzeppelin-solidity/contracts/utils/Address.sol";
+
+import "./IERC1155.sol";
+import "./IERC1155MetadataURI.sol";
+import "./IERC1155Receiver.sol";
+
+
+/**
+ * @title ERC1155
+ * @dev Basic implementation of the ERC1155 multi-token standard with the metadata URI extension.
+ */
+contract ERC1155 is ERC165, IERC1155, IERC1155MetadataURI {
+    using SafeMath for uint256;
+    using Address for address;
+
+    // Mapping from token ID to the balances of the owners
+    mapping (uint256 => mapping(address => uint256)) private _balances;
+
+    // Mapping from owner to operator approvals
+    mapping (address => mapping(address => bool)) private _operatorApprovals;
+
+    // Mapping from token ID to the token URI
+    mapping (uint256 => string) private _tokenURIs;
+
+    /*
+     *     bytes4(keccak256('balanceOf(address,uint256)')) == 0x00fdd58e
+     *     bytes4(keccak256('balanceOfBatch(address[],uint256[])')) == 0x4e1273f4
+     *     bytes4(keccak256('setApprovalForAll(address,bool)')) == 0xa22cb465
+     *     bytes4(keccak256('isApprovedForAll(address,address)')) == 0xe985e9c5
+     *     bytes4(keccak256('safeTransferFrom(address,address,uint256,uint256,bytes)')) == 0xf242432a
+     *     bytes4(keccak256('safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)')) == 0x2eb2c2d6
+     *
+     *     => 0x00fdd58e ^ 0x4e1273f4 ^ 0xa22cb465 ^
+     *        0xe985e9c5 ^ 0xf242432a ^ 0x2eb2c2d6 == 0xd9b67a26
+     */
+    bytes4 private constant _INTERFACE_ID_ERC1155 = 0xd9b67a26;
+
+    /*
+     *     bytes4(keccak256('uri(uint256)')) == 0x0e89341c
+     */
+    bytes4 private constant _INTERFACE_ID_ERC1155_METADATA_URI = 0x0e89341c;
+
+    // Equals to `bytes4(keccak256("onERC1155Received(address,address,uint256,uint256,bytes)"))`
+    bytes4 private constant _ERC1155_RECEIVED = 0xf23a6e61;
+
+    // Equals to `bytes4(keccak256("onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)"))`
+    bytes4 private constant _ERC1155_BATCH_RECEIVED = 0xbc197c81;
+
+    constructor () internal {
+        _registerInterface(_INTERFACE_ID_ERC1155);
+        _registerInterface(_INTERFACE_ID_ERC1155_METADATA_URI);
+    }
+
+    function uri(uint256 _id) public view returns (string memory) {
+        return _tokenURIs[_id];
+    }
+
+    function balanceOf(address _owner, uint256 _id) public view returns (uint256) {
+        require(_owner != address(0), "ERC1155: balance query for the zero address");
+        return _balances[_id][_owner];
+    }
+
+    function balanceOfBatch(address[] memory _owners, uint256[] memory _ids) public view returns (uint256[] memory) {
+        require(_owners.length == _ids.length, "ERC1155: owners and ids length mismatch");
+
+        uint256[] memory batchBalances = new uint256[](_owners.length);
+        for (uint256 i = 0; i < _owners.length; i++) {
+            batchBalances[i] = balanceOf(_owners[i], _ids[i]);
+        }
+        return batchBalances;
+    }
+
+    function setApprovalForAll(address _operator, bool _approved) public {
+        require(_operator != msg.sender, "ERC1155: setting approval status for self");
+
+        _operatorApprovals[msg.sender][_operator] = _approved;
+        emit ApprovalForAll(msg.sender, _operator, _approved);
+    }
+
+    function isApprovedForAll(address _owner, address _operator) public view returns (bool) {
+        return _operatorApprovals[_owner][_operator];
+    }
+
+    function safeTransferFrom(address _from, address _to, uint256 _id, uint256 _value, bytes memory _data) public {
+        require(_to != address(0), "ERC1155: transfer to the zero address");
+        require(_from == msg.sender || isApprovedForAll(_from, msg.sender), "ERC1155: caller is not owner nor approved");
+
+        _balances[_id][_from] = _balances[_id][_from].sub(_value);
+        _balances[_id][_to] = _balances[_id][_to].add(_value);
+        emit TransferSingle(msg.sender, _from, _to, _id, _value);
+
+        _checkOnERC1155Received(msg.sender, _from, _to, _id, _value, _data);
+    }
+
+    function safeBatchTransferFrom(
+        address _from,
+        address _to,
+        uint256[] memory _ids,
+        uint256[] memory _values,
+        bytes memory _data
+    )
+        public
+    {
+        require(_ids.length == _values.length, "ERC1155: ids and values length mismatch");
+        require(_to != address(0), "ERC1155: transfer to the zero address");
+        require(_from == msg.sender || isApprovedForAll(_from, msg.sender), "ERC1155: caller is not owner nor approved");
+
+        for (uint256 i = 0; i < _ids.length; i++) {
+            _balances[_ids[i]][_from] = _balances[_ids[i]][_from].sub(_values[i]);
+            _balances[_ids[i]][_to] = _balances[_ids[i]][_to].add(_values[i]);
+        }
+        emit TransferBatch(msg.sender, _from, _to, _ids, _values);
+
+        _checkOnERC1155BatchReceived(msg.sender, _from, _to, _ids, _values, _data);
+    }
+
+    function _mint(address _to, uint256 _id, uint256 _value, bytes memory _data) internal {
+        require(_to != address(0), "ERC1155: mint to the zero address");
+
+        _balances[_id][_to] = _balances[_id][_to].add(_value);
+        emit TransferSingle(msg.sender, address(0), _to, _id, _value);
+
+        _checkOnERC1155Received(msg.sender, address(0), _to, _id, _value, _data);
+    }
+
+    function _burn(address _owner, uint256 _id, uint256 _value) internal {
+        require(_owner != address(0), "ERC1155: burn from the zero address");
+
+        _balances[_id][_owner] = _balances[_id][_owner].sub(_value);
+        emit TransferSingle(msg.sender, _owner, address(0), _id, _value);
+    }
+
+    function _setURI(uint256 _id, string memory _uri) internal {
+        _tokenURIs[_id] = _uri;
+        emit URI(_uri, _id);
+    }
+
+    function _checkOnERC1155Received(
+        address _operator,
+        address _from,
+        address _to,
+        uint256 _id,
+        uint256 _value,
+        bytes memory _data
+    )
+        private
+    {
+        if (!_to.isContract()) {
+            return;
+        }
+
+        bytes4 retval = IERC1155Receiver(_to).onERC1155Received(_operator, _from, _id, _value, _data);
+        require(retval == _ERC1155_RECEIVED, "ERC1155: transfer to non ERC1155Receiver implementer");
+    }
+
+    function _checkOnERC1155BatchReceived(
+        address _operator,
+        address _from,
+        address _to,
+        uint256[] memory _ids,
+        uint256[] memory _values,
+        bytes memory _data
+    )
+        private
+    {
+        if (!_to.isContract()) {
+            return;
+        }
+
+        bytes4 retval = IERC1155Receiver(_to).onERC1155BatchReceived(_operator, _from, _ids, _values, _data);
+        require(retval == _ERC1155_BATCH_RECEIVED, "ERC1155: transfer to non ERC1155Receiver implementer");
+    }
+}
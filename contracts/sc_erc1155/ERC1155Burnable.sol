@@ -0,0 +1,33 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "./ERC1155.sol";
+import "./IERC1155Burnable.sol";
+
+
+/**
+ * @title ERC1155Burnable
+ * @dev ERC1155 tokens that can be irreversibly burned (destroyed).
+ */
+contract ERC1155Burnable is IERC1155Burnable, ERC1155 {
+    function burn(address _owner, uint256 _id, uint256 _value) public {
+        require(_owner == msg.sender || isApprovedForAll(_owner, msg.sender), "ERC1155: caller is not owner nor approved");
+        _burn(_owner, _id, _value);
+    }
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../externals/openzeppelin-solidity/contracts/access/roles/MinterRole.sol";
+
+import "./ERC1155.sol";
+import "./IERC1155Mintable.sol";
+
+
+/**
+ * @title ERC1155Mintable
+ * @dev ERC1155 minting logic.
+ */
+contract ERC1155Mintable is IERC1155Mintable, ERC1155, MinterRole {
+    function mint(address _to, uint256 _id, uint256 _value, bytes memory _data) public onlyMinter returns (bool) {
+        _mint(_to, _id, _value, _data);
+        return true;
+    }
+
+    // mintWithURI mints the tokens and updates the URI of the token ID if the given URI is not empty.
+    function mintWithURI(address _to, uint256 _id, uint256 _value, string memory _uri) public onlyMinter returns (bool) {
+        if (bytes(_uri).length > 0) {
+            _setURI(_id, _uri);
+        }
+        _mint(_to, _id, _value, "");
+        return true;
+    }
+}
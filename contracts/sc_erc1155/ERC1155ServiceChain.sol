@@ -0,0 +1,54 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../externals/openzeppelin-solidity/contracts/utils/Address.sol";
+import "../externals/openzeppelin-solidity/contracts/ownership/Ownable.sol";
+
+import "./ERC1155.sol";
+import "./IERC1155BridgeReceiver.sol";
+
+
+/**
+ * @title ERC1155ServiceChain
+ * @dev ERC1155 service chain value transfer logic for 1-step transfer.
+ */
+contract ERC1155ServiceChain is ERC1155, Ownable {
+    using Address for address;
+    address public bridge;
+
+    constructor(address _bridge) internal {
+        if (!_bridge.isContract()) {
+            revert("bridge is not a contract");
+        }
+
+        bridge = _bridge;
+    }
+
+    function setBridge(address _bridge) public onlyOwner {
+        bridge = _bridge;
+    }
+
+    // requestValueTransfer sends the tokens to the bridge and requests the value transfer.
+    // The sent KLAY is used as the fee limit of the request.
+    function requestValueTransfer(uint256 _id, uint256 _amount, address _to, bytes calldata _extraData) external payable {
+        safeTransferFrom(msg.sender, bridge, _id, _amount, "");
+
+        IERC1155BridgeReceiver(bridge).onERC1155BridgeReceived.value(msg.value)(msg.sender, _id, _amount, _to, _extraData);
+    }
+}
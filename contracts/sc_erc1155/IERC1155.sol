@@ -0,0 +1,41 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "../externals/openzeppelin-solidity/contracts/introspection/IERC165.sol";
+
+
+/**
+ * @title ERC1155 multi-token standard interface
+ * @dev See https://eips.ethereum.org/EIPS/eip-1155
+ */
+contract IERC1155 is IERC165 {
+    event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value);
+    event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values);
+    event ApprovalForAll(address indexed owner, address indexed operator, bool approved);
+    event URI(string value, uint256 indexed id);
+
+    function balanceOf(address owner, uint256 id) public view returns (uint256);
+    function balanceOfBatch(address[] memory owners, uint256[] memory ids) public view returns (uint256[] memory);
+
+    function setApprovalForAll(address operator, bool approved) public;
+    function isApprovedForAll(address owner, address operator) public view returns (bool);
+
+    function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes memory data) public;
+    function safeBatchTransferFrom(address from, address to, uint256[] memory ids, uint256[] memory values, bytes memory data) public;
+}
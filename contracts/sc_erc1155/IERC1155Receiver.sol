@@ -0,0 +1,41 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+/**
+ * @title ERC1155 token receiver interface
+ * @dev Interface for any contract that wants to support safe transfers
+ * from ERC1155 asset contracts.
+ */
+contract IERC1155Receiver {
+    /**
+     * @dev Handles the receipt of a single ERC1155 token type.
+     * It MUST return `bytes4(keccak256("onERC1155Received(address,address,uint256,uint256,bytes)"))`
+     * to accept the transfer.
+     */
+    function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes memory data)
+    public returns (bytes4);
+
+    /**
+     * @dev Handles the receipt of multiple ERC1155 token types.
+     * It MUST return `bytes4(keccak256("onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)"))`
+     * to accept the transfer.
+     */
+    function onERC1155BatchReceived(address operator, address from, uint256[] memory ids, uint256[] memory values, bytes memory data)
+    public returns (bytes4);
+}
@@ -0,0 +1,28 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+
+pragma solidity ^0.5.6;
+
+import "./ERC1155Mintable.sol";
+import "./ERC1155Burnable.sol";
+import "./ERC1155ServiceChain.sol";
+
+
+contract ServiceChainMultiToken is ERC1155Mintable, ERC1155Burnable, ERC1155ServiceChain {
+    constructor(address _bridge) ERC1155ServiceChain(_bridge) public {
+    }
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blake2b
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+// TestF checks that a single final compression of "abc" with 12 rounds yields the
+// BLAKE2b-512 digest of "abc" given in RFC 7693 Appendix A.
+func TestF(t *testing.T) {
+	h := iv
+	h[0] ^= 0x01010040 // digest length 64, fanout 1, depth 1
+
+	var m [16]uint64
+	m[0] = uint64('a') | uint64('b')<<8 | uint64('c')<<16
+
+	F(&h, m, [2]uint64{3, 0}, true, 12)
+
+	out := make([]byte, 64)
+	for i := range h {
+		binary.LittleEndian.PutUint64(out[i*8:], h[i])
+	}
+	expected := "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d1" +
+		"7d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"
+	if got := hex.EncodeToString(out); got != expected {
+		t.Errorf("unexpected digest\nhave: %s\nwant: %s", got, expected)
+	}
+}
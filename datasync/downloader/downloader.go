@@ -29,6 +29,7 @@ import (
 	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/metrics"
+	"github.com/klaytn/klaytn/networks/p2p"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 	"math/big"
@@ -329,7 +330,7 @@ func (d *Downloader) Synchronise(id string, head common.Hash, td *big.Int, mode
 		errEmptyHeaderSet, errPeersUnavailable, errTooOld,
 		errInvalidAncestor, errInvalidChain:
 		logger.Warn("Synchronisation failed, dropping peer", "peer", id, "err", err)
-		d.dropPeer(id)
+		d.dropPeer(id, syncErrorPenalty(err))
 
 	default:
 		logger.Warn("Synchronisation failed, retrying", "err", err)
@@ -337,6 +338,20 @@ func (d *Downloader) Synchronise(id string, head common.Hash, td *big.Int, mode
 	return err
 }
 
+// syncErrorPenalty returns the penalty of the peer which failed the synchronisation with the error.
+func syncErrorPenalty(err error) p2p.Penalty {
+	switch err {
+	case errInvalidAncestor, errInvalidChain:
+		return p2p.PenaltyBadBlock
+	case errTimeout, errStallingPeer:
+		return p2p.PenaltyTimeout
+	case errBadPeer, errEmptyHeaderSet:
+		return p2p.PenaltyProtocolError
+	default:
+		return p2p.PenaltyUselessPeer
+	}
+}
+
 // synchronise will select the peer and use it for synchronising. If an empty string is given
 // it will use the best peer possible and synchronize if its TD is higher than our own. If any of the
 // checks fail an error will be returned. This method is synchronous
@@ -894,7 +909,7 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64, pivot uint64)
 			// Header retrieval timed out, consider the peer bad and drop
 			p.logger.Debug("Header request timed out", "elapsed", ttl)
 			headerTimeoutMeter.Mark(1)
-			d.dropPeer(p.id)
+			d.dropPeer(p.id, p2p.PenaltyTimeout)
 
 			// Finish the sync gracefully instead of dumping the gathered data though
 			for _, ch := range []chan bool{d.bodyWakeCh, d.receiptWakeCh} {
@@ -1111,7 +1126,7 @@ func (d *Downloader) fetchParts(errCancel error, deliveryCh chan dataPack, deliv
 						setIdle(peer, 0)
 					} else {
 						peer.logger.Debug("Stalling delivery, dropping", "type", kind)
-						d.dropPeer(pid)
+						d.dropPeer(pid, p2p.PenaltyTimeout)
 					}
 				}
 			}
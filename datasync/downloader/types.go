@@ -23,10 +23,12 @@ package downloader
 import (
 	"fmt"
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/networks/p2p"
 )
 
 // peerDropFn is a callback type for dropping a peer detected as malicious.
-type peerDropFn func(id string)
+// The penalty is added to the score of the peer.
+type peerDropFn func(id string, penalty p2p.Penalty)
 
 // dataPack is a data message returned by a peer for some query.
 type dataPack interface {
@@ -26,6 +26,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
 	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
 	"math/rand"
 	"time"
@@ -74,7 +75,8 @@ type chainHeightFn func() uint64
 type chainInsertFn func(types.Blocks) (int, error)
 
 // peerDropFn is a callback type for dropping a peer detected as malicious.
-type peerDropFn func(id string)
+// The penalty is added to the score of the peer.
+type peerDropFn func(id string, penalty p2p.Penalty)
 
 // announce is the hash notification of the availability of a new block in the
 // network.
@@ -484,7 +486,7 @@ func (f *Fetcher) loop() {
 					// If the delivered header does not match the promised number, drop the announcer
 					if header.Number.Uint64() != announce.number {
 						logger.Trace("Invalid block number fetched", "peer", announce.origin, "hash", header.Hash(), "announced", announce.number, "provided", header.Number)
-						f.dropPeer(announce.origin)
+						f.dropPeer(announce.origin, p2p.PenaltyProtocolError)
 						f.forgetHash(hash)
 						continue
 					}
@@ -698,7 +700,7 @@ func (f *Fetcher) insertWork(peer string, block *types.Block) {
 	default:
 		// Something went very wrong, drop the peer
 		logger.Debug("Propagated block verification failed", "peer", peer, "number", blockNum, "hash", hash, "err", err)
-		f.dropPeer(peer)
+		f.dropPeer(peer, p2p.PenaltyBadBlock)
 		return
 	}
 	// Run the actual import and log any issues
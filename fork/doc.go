@@ -17,10 +17,12 @@
 /*
 Package fork has information related to hard forks.
 
-HardForkConfig which has hard fork blockNumbers is declared in this package.
+The hard fork block numbers are defined in params.ChainConfig. This package keeps the chain config
+of the running blockchain so that the packages which only know a block number, like the transaction
+types, can find the rules activated at the block.
 
 Source Files
 
-- fork.go : Declare HardForkConfig
+- fork.go : Provides the rules of the hard forks activated at a block number
 */
 package fork
@@ -16,20 +16,47 @@
 
 package fork
 
+import (
+	"errors"
+	"github.com/klaytn/klaytn/params"
+	"math/big"
+	"sync/atomic"
+)
+
 var (
-	// hardForkConfig will not be changed unless it is a test code.
-	// The test code can override this value via `UpdateHardForkConfig`.
-	hardForkConfig = &HardForkConfig{}
+	// hardForkBlockNumberConfig is the chain config of the running blockchain which has
+	// the hard fork block numbers. It is set when the blockchain is created.
+	// The test code can override this value via `SetHardForkBlockNumberConfig`.
+	hardForkBlockNumberConfig atomic.Value // *params.ChainConfig
+
+	errNoChainConfig = errors.New("the hard fork block number config is not set")
 )
 
-// HardForkConfig defines a block number for each hard fork feature.
-type HardForkConfig struct {
+// SetHardForkBlockNumberConfig sets the chain config which has the hard fork block numbers.
+// The packages which only know the block number, like the transaction types, refer to it
+// to find the rules activated at the block.
+func SetHardForkBlockNumberConfig(config *params.ChainConfig) error {
+	if config == nil {
+		return errNoChainConfig
+	}
+	hardForkBlockNumberConfig.Store(config)
+	return nil
 }
 
-// UpdateHardForkConfig sets values in HardForkConfig if it is not nil.
-// NOTE: this is only for test code to give flexibility of the test code.
-func UpdateHardForkConfig(h *HardForkConfig) {
-	if h != nil {
-		hardForkConfig = h
+// Rules returns the rules activated at the given block number.
+// It returns an error if the hard fork block number config is not set.
+func Rules(blockNumber *big.Int) (*params.Rules, error) {
+	config, ok := hardForkBlockNumberConfig.Load().(*params.ChainConfig)
+	if !ok || config == nil {
+		return nil, errNoChainConfig
 	}
+	rules := config.Rules(blockNumber)
+	return &rules, nil
+}
+
+// IsIstanbul returns true if the Istanbul hard fork is activated at the given block number.
+// It returns false if the hard fork block number config is not set.
+func IsIstanbul(blockNumber uint64) bool {
+	rules, err := Rules(new(big.Int).SetUint64(blockNumber))
+	return err == nil && rules.IsIstanbul
 }
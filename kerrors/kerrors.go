@@ -21,8 +21,8 @@ import "errors"
 // TODO-Klaytn: Use integer for error codes.
 // TODO-Klaytn: Integrate all universally accessible errors into kerrors package.
 var (
-	ErrNotHumanReadableAddress    = errors.New("Human-readable address is not supported now")
-	ErrHumanReadableNotSupported  = errors.New("Human-readable address is not supported now")
+	ErrNotHumanReadableAddress    = errors.New("the recipient is not a valid human-readable address")
+	ErrHumanReadableNotSupported  = errors.New("human-readable address is not supported for smart contracts")
 	ErrInvalidContractAddress     = errors.New("contract deploy transaction can't have a recipient address")
 	ErrOutOfGas                   = errors.New("out of gas")
 	ErrMaxKeysExceed              = errors.New("the number of keys exceeds the limit")
@@ -50,6 +50,7 @@ var (
 	ErrLengthTooLong                        = errors.New("length too long")
 	ErrNestedCompositeType                  = errors.New("nested composite type")
 	ErrLegacyTransactionMustBeWithLegacyKey = errors.New("a legacy transaction must be with a legacy account key")
+	ErrLegacyKeyForHumanReadable            = errors.New("a human-readable address cannot have a legacy account key")
 
 	ErrDeprecated   = errors.New("deprecated feature")
 	ErrNotSupported = errors.New("not supported")
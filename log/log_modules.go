@@ -115,6 +115,10 @@ const (
 
 	// 51~60
 	CMDKSEN
+	AccountsHDWallet
+	AccountsExternal
+	CMDKSIGNER
+	NodeFeePayer
 
 	// ModuleNameLen should be placed at the end of the list.
 	ModuleNameLen
@@ -186,4 +190,8 @@ var moduleNames = [ModuleNameLen]string{
 
 	// 51~60
 	"cmd/ksen",
+	"accounts/hdwallet",
+	"accounts/external",
+	"cmd/ksigner",
+	"node/feepayer",
 }
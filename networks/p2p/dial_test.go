@@ -122,9 +122,15 @@ func (t fakeTable) IsAuthorized(id discover.NodeID, ntype discover.NodeType) boo
 	return true
 }
 
-func (t fakeTable) GetAuthorizedNodes() []*discover.Node         { return nil }
-func (t fakeTable) PutAuthorizedNodes(nodes []*discover.Node)    {}
-func (t fakeTable) DeleteAuthorizedNodes(nodes []*discover.Node) {}
+func (t fakeTable) GetAuthorizedNodes() []*discover.Node                            { return nil }
+func (t fakeTable) PutAuthorizedNodes(nodes []*discover.Node)                       {}
+func (t fakeTable) DeleteAuthorizedNodes(nodes []*discover.Node)                    {}
+func (t fakeTable) ListAuthorizedNodes() []*discover.AuthorizedNode                 { return nil }
+func (t fakeTable) PutAuthorizedNodeEntries(nodes []*discover.AuthorizedNode)       {}
+func (t fakeTable) GetAuthorizedNodeAudits(max int) []*discover.AuthorizedNodeAudit { return nil }
+func (t fakeTable) GetBans() []*discover.Ban                                        { return nil }
+func (t fakeTable) PutBan(ban *discover.Ban) error                                  { return nil }
+func (t fakeTable) DeleteBan(ban *discover.Ban) error                               { return nil }
 
 // This test checks that dynamic dials are launched from discovery results.
 func TestDialStateDynDial(t *testing.T) {
@@ -963,3 +969,27 @@ func (t *resolveMock) PutAuthorizedNodes(nodes []*discover.Node) {
 func (t *resolveMock) DeleteAuthorizedNodes(nodes []*discover.Node) {
 	panic("implement me")
 }
+
+func (t *resolveMock) ListAuthorizedNodes() []*discover.AuthorizedNode {
+	panic("implement me")
+}
+
+func (t *resolveMock) PutAuthorizedNodeEntries(nodes []*discover.AuthorizedNode) {
+	panic("implement me")
+}
+
+func (t *resolveMock) GetAuthorizedNodeAudits(max int) []*discover.AuthorizedNodeAudit {
+	panic("implement me")
+}
+
+func (t *resolveMock) GetBans() []*discover.Ban {
+	panic("implement me")
+}
+
+func (t *resolveMock) PutBan(ban *discover.Ban) error {
+	panic("implement me")
+}
+
+func (t *resolveMock) DeleteBan(ban *discover.Ban) error {
+	panic("implement me")
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Actions recorded in the audit log of the authorized nodes.
+const (
+	AuthorizedNodeActionPut    = "put"
+	AuthorizedNodeActionDelete = "delete"
+	AuthorizedNodeActionExpire = "expire"
+)
+
+// AuthorizedNode is an entry of the authorized node registry of a bootnode.
+// The role of the node is its node type.
+type AuthorizedNode struct {
+	Node      *Node
+	AddedAt   time.Time
+	ExpiresAt time.Time // zero if the entry never expires
+}
+
+// expired returns true if the entry has expired at the given time.
+func (n *AuthorizedNode) expired(now time.Time) bool {
+	return !n.ExpiresAt.IsZero() && !now.Before(n.ExpiresAt)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *AuthorizedNode) MarshalJSON() ([]byte, error) {
+	var expiresAt *time.Time
+	if !n.ExpiresAt.IsZero() {
+		expiresAt = &n.ExpiresAt
+	}
+	return json.Marshal(struct {
+		Node      *Node      `json:"node"`
+		Role      string     `json:"role"`
+		AddedAt   time.Time  `json:"addedAt"`
+		ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	}{n.Node, nodeTypeName(n.Node.NType), n.AddedAt, expiresAt})
+}
+
+// AuthorizedNodeAudit is a record of a change of the authorized node registry.
+type AuthorizedNodeAudit struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	ID     NodeID    `json:"id"`
+	Role   string    `json:"role"`
+}
+
+// authorizedNodeSet is the set of authorized nodes of a storage. Every node is
+// authorized until the set is restricted by putting a node. Once restricted, only the
+// entries which are not expired are authorized, even after all of them expire, until
+// the last entry is deleted explicitly.
+type authorizedNodeSet struct {
+	lock       sync.RWMutex
+	nodes      map[NodeID]*AuthorizedNode
+	restricted bool
+}
+
+func (s *authorizedNodeSet) isAuthorized(id NodeID) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.restricted {
+		return true
+	}
+	n, ok := s.nodes[id]
+	return ok && !n.expired(time.Now())
+}
+
+// list returns the entries which are not expired.
+func (s *authorizedNodeSet) list() []*AuthorizedNode {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	now := time.Now()
+	var ret []*AuthorizedNode
+	for _, n := range s.nodes {
+		if !n.expired(now) {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+func (s *authorizedNodeSet) put(n *AuthorizedNode) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.nodes == nil {
+		s.nodes = make(map[NodeID]*AuthorizedNode)
+	}
+	s.nodes[n.Node.ID] = n
+	s.restricted = true
+}
+
+// restrict restricts the set to its entries even if it has no entry.
+func (s *authorizedNodeSet) restrict() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.restricted = true
+}
+
+// delete removes the entry of the node. Every node is authorized again if the set
+// has no entry left.
+func (s *authorizedNodeSet) delete(id NodeID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.nodes[id]; ok {
+		delete(s.nodes, id)
+	} else {
+		logger.Debug("No node to be removed", "nodeid", id)
+	}
+}
+
+func ParseAuthorizedNodes(r io.Reader) ([]*AuthorizedNode, error) {
+	var (
+		nodes   []*AuthorizedNode
+		scanner = bufio.NewScanner(r)
+		now     = time.Now()
+	)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: too many fields", line)
+		}
+		node, err := ParseNode(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		entry := &AuthorizedNode{Node: node, AddedAt: now}
+		if len(fields) > 1 {
+			role := ParseNodeType(strings.ToLower(fields[1]))
+			if role == NodeTypeUnknown {
+				return nil, fmt.Errorf("line %d: invalid role %q", line, fields[1])
+			}
+			if node.NType != NodeTypeUnknown && node.NType != role {
+				return nil, fmt.Errorf("line %d: role %s differs from the node type %s", line, fields[1], StringNodeType(node.NType))
+			}
+			node.NType = role
+		}
+		if node.NType == NodeTypeUnknown {
+			return nil, fmt.Errorf("line %d: missing role", line)
+		}
+		if len(fields) > 2 {
+			if entry.ExpiresAt, err = time.Parse(time.RFC3339, fields[2]); err != nil {
+				return nil, fmt.Errorf("line %d: invalid expiry time (%v)", line, err)
+			}
+		}
+		nodes = append(nodes, entry)
+	}
+	return nodes, scanner.Err()
+}
+
+// LoadAuthorizedNodesFile reads the authorized nodes from the file at path.
+// See ParseAuthorizedNodes for the format.
+func LoadAuthorizedNodesFile(path string) ([]*AuthorizedNode, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseAuthorizedNodes(f)
+}
@@ -0,0 +1,207 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"github.com/klaytn/klaytn/log"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newAuthorizedNodesTestTable(t *testing.T, path string, configured []*Node) *Table {
+	db, err := newNodeDB(path, Version, NodeID{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tab := &Table{
+		db:          db,
+		storages:    make(map[NodeType]discoverStorage),
+		localLogger: log.NewModuleLogger(log.NetworksP2PDiscover).NewWith("Discover", "Test"),
+	}
+	tab.addStorage(NodeTypeCN, &simpleStorage{targetType: NodeTypeCN, noDiscover: true, max: 100})
+	tab.addStorage(NodeTypePN, &simpleStorage{targetType: NodeTypePN, noDiscover: true, max: 100})
+	tab.addStorage(NodeTypeEN, &KademliaStorage{targetType: NodeTypeEN, noDiscover: true})
+	tab.loadAuthorizedNodes(configured)
+	return tab
+}
+
+// TestAuthorizedNodes_Persistence checks that the authorized nodes survive a restart,
+// and that the expired ones are removed with audit records.
+func TestAuthorizedNodes_Persistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorized-nodes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nodes")
+
+	var (
+		cn      = testData[NodeTypeCN][0]
+		pn      = testData[NodeTypePN][0]
+		en      = testData[NodeTypeEN][0]
+		expired = testData[NodeTypeCN][1]
+	)
+	tab := newAuthorizedNodesTestTable(t, path, []*Node{cn})
+	assert.True(t, tab.IsAuthorized(cn.ID, NodeTypeCN))
+	assert.False(t, tab.IsAuthorized(testData[NodeTypeCN][2].ID, NodeTypeCN))
+	assert.True(t, tab.IsAuthorized(pn.ID, NodeTypePN)) // No PN is authorized yet
+
+	tab.PutAuthorizedNodes([]*Node{pn, en})
+	tab.PutAuthorizedNodeEntries([]*AuthorizedNode{{Node: expired, AddedAt: time.Now(), ExpiresAt: time.Now().Add(-time.Second)}})
+	assert.True(t, tab.IsAuthorized(pn.ID, NodeTypePN))
+	assert.False(t, tab.IsAuthorized(testData[NodeTypePN][1].ID, NodeTypePN))
+	assert.False(t, tab.IsAuthorized(testData[NodeTypeEN][1].ID, NodeTypeEN))
+	assert.False(t, tab.IsAuthorized(expired.ID, NodeTypeCN))
+	assert.Equal(t, 3, len(tab.GetAuthorizedNodes()))
+	tab.db.close()
+
+	// The stored nodes are loaded again, and the configured node is not duplicated.
+	tab = newAuthorizedNodesTestTable(t, path, []*Node{cn})
+	defer tab.db.close()
+	assert.Equal(t, 3, len(tab.ListAuthorizedNodes()))
+	assert.True(t, tab.IsAuthorized(en.ID, NodeTypeEN))
+
+	tab.expireAuthorizedNodes()
+	tab.DeleteAuthorizedNodes([]*Node{en})
+	assert.False(t, tab.IsAuthorized(en.ID, NodeTypeEN))
+	assert.False(t, tab.IsAuthorized(testData[NodeTypeEN][1].ID, NodeTypeEN))
+	assert.Equal(t, 2, len(tab.db.authorizedNodes()))
+
+	var actions []string
+	for _, audit := range tab.GetAuthorizedNodeAudits(0) {
+		actions = append(actions, audit.Action+" "+audit.Role)
+	}
+	assert.Equal(t, []string{"delete EN", "expire CN", "put CN", "put EN", "put PN", "put CN"}, actions)
+	assert.Equal(t, 2, len(tab.GetAuthorizedNodeAudits(2)))
+}
+
+// TestAuthorizedNodes_Expiry checks that a node type stays restricted after all of its
+// authorized nodes expire or are deleted, even after a restart.
+func TestAuthorizedNodes_Expiry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorized-nodes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nodes")
+
+	var (
+		pn    = testData[NodeTypePN][0]
+		other = testData[NodeTypePN][1]
+	)
+	tab := newAuthorizedNodesTestTable(t, path, nil)
+	tab.PutAuthorizedNodeEntries([]*AuthorizedNode{{Node: pn, AddedAt: time.Now(), ExpiresAt: time.Now().Add(-time.Second)}})
+	assert.False(t, tab.IsAuthorized(pn.ID, NodeTypePN))
+	assert.False(t, tab.IsAuthorized(other.ID, NodeTypePN))
+
+	tab.expireAuthorizedNodes()
+	assert.Equal(t, 0, len(tab.db.authorizedNodes()))
+	assert.False(t, tab.IsAuthorized(other.ID, NodeTypePN))
+	assert.True(t, tab.IsAuthorized(testData[NodeTypeCN][0].ID, NodeTypeCN)) // No CN is authorized yet
+	tab.db.close()
+
+	// The restriction is loaded again without any authorized node.
+	tab = newAuthorizedNodesTestTable(t, path, nil)
+	defer tab.db.close()
+	assert.False(t, tab.IsAuthorized(other.ID, NodeTypePN))
+
+	// Deleting the last node explicitly does not lift the restriction either.
+	tab.PutAuthorizedNodes([]*Node{pn})
+	tab.DeleteAuthorizedNodes([]*Node{pn})
+	assert.False(t, tab.IsAuthorized(pn.ID, NodeTypePN))
+	assert.False(t, tab.IsAuthorized(other.ID, NodeTypePN))
+	assert.True(t, tab.db.authorizedNodesRestricted(NodeTypePN))
+}
+
+// TestAuthorizedNodes_Delete checks that deleting an unknown node changes nothing, and that
+// a configured node deleted through the API is not authorized again after a restart.
+func TestAuthorizedNodes_Delete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authorized-nodes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nodes")
+
+	var (
+		cn      = testData[NodeTypeCN][0]
+		unknown = testData[NodeTypePN][0]
+	)
+	tab := newAuthorizedNodesTestTable(t, path, []*Node{cn})
+	tab.DeleteAuthorizedNodes([]*Node{unknown})
+	assert.True(t, tab.IsAuthorized(unknown.ID, NodeTypePN)) // No PN is authorized yet
+	assert.False(t, tab.db.authorizedNodesRestricted(NodeTypePN))
+	assert.Equal(t, 1, len(tab.GetAuthorizedNodeAudits(0)))
+
+	tab.DeleteAuthorizedNodes([]*Node{cn})
+	assert.False(t, tab.IsAuthorized(cn.ID, NodeTypeCN))
+	tab.db.close()
+
+	// The configured node deleted through the API is not loaded again.
+	tab = newAuthorizedNodesTestTable(t, path, []*Node{cn})
+	assert.False(t, tab.IsAuthorized(cn.ID, NodeTypeCN))
+	assert.Equal(t, 0, len(tab.ListAuthorizedNodes()))
+
+	var actions []string
+	for _, audit := range tab.GetAuthorizedNodeAudits(0) {
+		actions = append(actions, audit.Action+" "+audit.Role)
+	}
+	assert.Equal(t, []string{"delete CN", "put CN"}, actions)
+
+	// The node authorized again through the API is loaded again.
+	tab.PutAuthorizedNodes([]*Node{cn})
+	tab.db.close()
+
+	tab = newAuthorizedNodesTestTable(t, path, []*Node{cn})
+	defer tab.db.close()
+	assert.True(t, tab.IsAuthorized(cn.ID, NodeTypeCN))
+	assert.Equal(t, 1, len(tab.ListAuthorizedNodes()))
+}
+
+func TestParseAuthorizedNodes(t *testing.T) {
+	list := strings.Join([]string{
+		"# authorized nodes",
+		testData[NodeTypeCN][0].String(),
+		"",
+		testData[NodeTypeUnknown][0].String() + " PN 2030-01-02T15:04:05Z",
+		testData[NodeTypeEN][0].String() + " en",
+	}, "\n")
+	nodes, err := ParseAuthorizedNodes(strings.NewReader(list))
+	if assert.NoError(t, err) && assert.Equal(t, 3, len(nodes)) {
+		assert.Equal(t, NodeTypeCN, nodes[0].Node.NType)
+		assert.True(t, nodes[0].ExpiresAt.IsZero())
+		assert.Equal(t, NodeTypePN, nodes[1].Node.NType)
+		assert.Equal(t, time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC), nodes[1].ExpiresAt.UTC())
+		assert.Equal(t, NodeTypeEN, nodes[2].Node.NType)
+	}
+
+	for _, invalid := range []string{
+		testData[NodeTypeUnknown][0].String(),                 // missing role
+		testData[NodeTypeUnknown][0].String() + " XN",         // invalid role
+		testData[NodeTypeCN][0].String() + " PN",              // conflicting role
+		testData[NodeTypeCN][0].String() + " CN tomorrow",     // invalid expiry
+		testData[NodeTypeCN][0].String() + " CN 2030-01-02 x", // too many fields
+	} {
+		_, err := ParseAuthorizedNodes(strings.NewReader(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"net"
+	"time"
+
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Ban is a ban of a node ID or an IP address from connecting to the local node.
+type Ban struct {
+	ID     NodeID    `json:"id"` // zero for an IP ban
+	IP     net.IP    `json:"ip"` // nil for a node ID ban
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason"`
+}
+
+// IsIPBan returns true if the ban is of an IP address.
+func (b *Ban) IsIPBan() bool {
+	return b.IP != nil
+}
+
+// Expired returns true if the ban has expired at the given time.
+func (b *Ban) Expired(now time.Time) bool {
+	return !now.Before(b.Until)
+}
+
+// banRLP is the database encoding of Ban.
+type banRLP struct {
+	ID     NodeID
+	IP     net.IP
+	Until  uint64 // unix time
+	Reason string
+}
+
+// makeBanKey generates the key of a ban. The node ID bans and the IP bans are
+// distinguished by the byte following the prefix.
+func makeBanKey(b *Ban) []byte {
+	key := append([]byte{}, nodeDBBanPrefix...)
+	if b.IsIPBan() {
+		return append(append(key, 1), b.IP.To16()...)
+	}
+	return append(append(key, 0), b.ID[:]...)
+}
+
+// bans retrieves the bans, including expired ones.
+func (db *nodeDB) bans() []*Ban {
+	it := db.lvl.NewIterator(util.BytesPrefix(nodeDBBanPrefix), nil)
+	defer it.Release()
+
+	var bans []*Ban
+	for it.Next() {
+		var enc banRLP
+		if err := rlp.DecodeBytes(it.Value(), &enc); err != nil {
+			logger.Error("Failed to decode ban RLP, It removed in the node database", "key", it.Key(), "err", err)
+			db.lvl.Delete(it.Key(), nil)
+			continue
+		}
+		ban := &Ban{ID: enc.ID, Until: time.Unix(int64(enc.Until), 0), Reason: enc.Reason}
+		if len(enc.IP) > 0 {
+			ban.IP = enc.IP
+		}
+		bans = append(bans, ban)
+	}
+	return bans
+}
+
+// updateBan inserts - potentially overwriting - a ban into the database.
+func (db *nodeDB) updateBan(b *Ban) error {
+	blob, err := rlp.EncodeToBytes(&banRLP{ID: b.ID, IP: b.IP, Until: uint64(b.Until.Unix()), Reason: b.Reason})
+	if err != nil {
+		return err
+	}
+	return db.lvl.Put(makeBanKey(b), blob, nil)
+}
+
+// deleteBan deletes a ban from the database.
+func (db *nodeDB) deleteBan(b *Ban) error {
+	return db.lvl.Delete(makeBanKey(b), nil)
+}
+
+// BanDB stores the bans in the node database when the node table is not running.
+type BanDB struct {
+	db *nodeDB
+}
+
+// OpenBanDB opens the node database at the given path to store the bans.
+// The bans are kept in memory if the path is empty.
+func OpenBanDB(path string, self NodeID) (*BanDB, error) {
+	db, err := newNodeDB(path, Version, self)
+	if err != nil {
+		return nil, err
+	}
+	return &BanDB{db: db}, nil
+}
+
+// GetBans returns the stored bans, including expired ones.
+func (b *BanDB) GetBans() []*Ban {
+	return b.db.bans()
+}
+
+// PutBan stores the ban.
+func (b *BanDB) PutBan(ban *Ban) error {
+	return b.db.updateBan(ban)
+}
+
+// DeleteBan deletes the ban.
+func (b *BanDB) DeleteBan(ban *Ban) error {
+	return b.db.deleteBan(ban)
+}
+
+// Close closes the node database.
+func (b *BanDB) Close() {
+	b.db.close()
+}
@@ -62,6 +62,12 @@ var (
 	nodeDBVersionKey = []byte("version") // Version of the database to flush if changes
 	nodeDBItemPrefix = []byte("n:")      // Identifier to prefix node entries with
 
+	nodeDBAuthorizedPrefix = []byte("auth:")       // Identifier to prefix authorized node entries with
+	nodeDBRestrictedPrefix = []byte("restricted:") // Identifier to prefix node types restricted to the authorized nodes with
+	nodeDBDeletedPrefix    = []byte("deleted:")    // Identifier to prefix authorized nodes deleted through the API with
+	nodeDBAuditPrefix      = []byte("audit:")      // Identifier to prefix audit records of authorized nodes with
+	nodeDBBanPrefix        = []byte("ban:")        // Identifier to prefix ban entries with
+
 	nodeDBDiscoverRoot      = ":discover"
 	nodeDBDiscoverPing      = nodeDBDiscoverRoot + ":lastping"
 	nodeDBDiscoverPong      = nodeDBDiscoverRoot + ":lastpong"
@@ -370,6 +376,143 @@ func nextNode(db *nodeDB, it iterator.Iterator) *Node {
 	return nil
 }
 
+// authorizedNodeRLP is the database encoding of AuthorizedNode.
+type authorizedNodeRLP struct {
+	Node      *Node
+	AddedAt   uint64 // unix time
+	ExpiresAt uint64 // unix time, or zero if the entry never expires
+}
+
+// authorizedNodeAuditRLP is the database encoding of AuthorizedNodeAudit.
+type authorizedNodeAuditRLP struct {
+	Time   uint64 // unix time in nanoseconds
+	Action string
+	ID     NodeID
+	Role   string
+}
+
+// makeAuthorizedKey generates the key of an authorized node entry. The entries are
+// grouped by the node type.
+func makeAuthorizedKey(nType NodeType, id NodeID) []byte {
+	key := append(append([]byte{}, nodeDBAuthorizedPrefix...), byte(nType))
+	return append(key, id[:]...)
+}
+
+// makeDeletedKey generates the key of a record of an authorized node deleted through the API.
+func makeDeletedKey(nType NodeType, id NodeID) []byte {
+	key := append(append([]byte{}, nodeDBDeletedPrefix...), byte(nType))
+	return append(key, id[:]...)
+}
+
+// authorizedNodes retrieves the authorized nodes of all node types, including expired ones.
+func (db *nodeDB) authorizedNodes() []*AuthorizedNode {
+	it := db.lvl.NewIterator(util.BytesPrefix(nodeDBAuthorizedPrefix), nil)
+	defer it.Release()
+
+	var nodes []*AuthorizedNode
+	for it.Next() {
+		var enc authorizedNodeRLP
+		if err := rlp.DecodeBytes(it.Value(), &enc); err != nil || enc.Node == nil {
+			logger.Error("Failed to decode authorized node RLP, It removed in the node database", "key", it.Key(), "err", err)
+			db.lvl.Delete(it.Key(), nil)
+			continue
+		}
+		enc.Node.sha = crypto.Keccak256Hash(enc.Node.ID[:])
+		n := &AuthorizedNode{Node: enc.Node, AddedAt: time.Unix(int64(enc.AddedAt), 0)}
+		if enc.ExpiresAt != 0 {
+			n.ExpiresAt = time.Unix(int64(enc.ExpiresAt), 0)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// updateAuthorizedNode inserts - potentially overwriting - an authorized node into the database.
+func (db *nodeDB) updateAuthorizedNode(n *AuthorizedNode) error {
+	enc := authorizedNodeRLP{Node: n.Node, AddedAt: uint64(n.AddedAt.Unix())}
+	if !n.ExpiresAt.IsZero() {
+		enc.ExpiresAt = uint64(n.ExpiresAt.Unix())
+	}
+	blob, err := rlp.EncodeToBytes(&enc)
+	if err != nil {
+		return err
+	}
+	if err := db.lvl.Put(makeAuthorizedKey(n.Node.NType, n.Node.ID), blob, nil); err != nil {
+		return err
+	}
+	// The node authorized again is no longer regarded as deleted.
+	return db.lvl.Delete(makeDeletedKey(n.Node.NType, n.Node.ID), nil)
+}
+
+// hasAuthorizedNode returns true if the authorized node is stored in the database.
+func (db *nodeDB) hasAuthorizedNode(nType NodeType, id NodeID) bool {
+	ok, _ := db.lvl.Has(makeAuthorizedKey(nType, id), nil)
+	return ok
+}
+
+// deleteAuthorizedNode deletes an authorized node from the database.
+func (db *nodeDB) deleteAuthorizedNode(nType NodeType, id NodeID) error {
+	return db.lvl.Delete(makeAuthorizedKey(nType, id), nil)
+}
+
+// authorizedNodeDeleted returns true if the authorized node was deleted through the API.
+func (db *nodeDB) authorizedNodeDeleted(nType NodeType, id NodeID) bool {
+	ok, _ := db.lvl.Has(makeDeletedKey(nType, id), nil)
+	return ok
+}
+
+// markAuthorizedNodeDeleted records that the authorized node was deleted through the API.
+func (db *nodeDB) markAuthorizedNodeDeleted(nType NodeType, id NodeID) error {
+	return db.lvl.Put(makeDeletedKey(nType, id), []byte{1}, nil)
+}
+
+// authorizedNodesRestricted returns true if the node type is restricted to the authorized nodes.
+func (db *nodeDB) authorizedNodesRestricted(nType NodeType) bool {
+	ok, _ := db.lvl.Has(append(append([]byte{}, nodeDBRestrictedPrefix...), byte(nType)), nil)
+	return ok
+}
+
+// restrictAuthorizedNodes stores that the node type is restricted to the authorized nodes.
+func (db *nodeDB) restrictAuthorizedNodes(nType NodeType) error {
+	return db.lvl.Put(append(append([]byte{}, nodeDBRestrictedPrefix...), byte(nType)), []byte{1}, nil)
+}
+
+// addAuthorizedNodeAudit appends a record to the audit log of the authorized nodes.
+func (db *nodeDB) addAuthorizedNodeAudit(audit *AuthorizedNodeAudit) error {
+	enc := authorizedNodeAuditRLP{Time: uint64(audit.Time.UnixNano()), Action: audit.Action, ID: audit.ID, Role: audit.Role}
+	blob, err := rlp.EncodeToBytes(&enc)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, len(nodeDBAuditPrefix)+8, len(nodeDBAuditPrefix)+8+len(audit.ID))
+	copy(key, nodeDBAuditPrefix)
+	binary.BigEndian.PutUint64(key[len(nodeDBAuditPrefix):], enc.Time)
+	return db.lvl.Put(append(key, audit.ID[:]...), blob, nil)
+}
+
+// authorizedNodeAudits retrieves at most max records of the audit log, the latest first.
+// It retrieves all the records if max is not positive.
+func (db *nodeDB) authorizedNodeAudits(max int) []*AuthorizedNodeAudit {
+	it := db.lvl.NewIterator(util.BytesPrefix(nodeDBAuditPrefix), nil)
+	defer it.Release()
+
+	var audits []*AuthorizedNodeAudit
+	for ok := it.Last(); ok && (max <= 0 || len(audits) < max); ok = it.Prev() {
+		var enc authorizedNodeAuditRLP
+		if err := rlp.DecodeBytes(it.Value(), &enc); err != nil {
+			logger.Error("Failed to decode authorized node audit RLP", "key", it.Key(), "err", err)
+			continue
+		}
+		audits = append(audits, &AuthorizedNodeAudit{
+			Time:   time.Unix(0, int64(enc.Time)),
+			Action: enc.Action,
+			ID:     enc.ID,
+			Role:   enc.Role,
+		})
+	}
+	return audits
+}
+
 // close flushes and closes the database files.
 func (db *nodeDB) close() {
 	close(db.quit)
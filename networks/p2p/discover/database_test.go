@@ -390,3 +390,38 @@ func TestNodeDBSelfExpiration(t *testing.T) {
 		t.Errorf("self not evacuated")
 	}
 }
+
+func TestNodeDBBans(t *testing.T) {
+	db, _ := newNodeDB("", Version, NodeID{})
+	defer db.close()
+
+	until := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	bans := []*Ban{
+		{ID: MustHexID("0x1dd9d65c4552b5eb43d5ad55a2ee3f56c6cbc1c64a5c8d659f51fcd51bace24351232b8d7821617d2b29b54b81cdefb9b3e9c37d7fd5f63270bcc9e1a6f6a439"), Until: until, Reason: "id"},
+		{IP: net.ParseIP("10.0.0.1"), Until: until, Reason: "ip"},
+	}
+	for i, ban := range bans {
+		if err := db.updateBan(ban); err != nil {
+			t.Fatalf("ban %d: failed to insert: %v", i, err)
+		}
+	}
+	stored := db.bans()
+	if len(stored) != len(bans) {
+		t.Fatalf("ban count mismatch: have %d, want %d", len(stored), len(bans))
+	}
+	for _, ban := range stored {
+		want := bans[0]
+		if ban.IsIPBan() {
+			want = bans[1]
+		}
+		if ban.ID != want.ID || !ban.IP.Equal(want.IP) || !ban.Until.Equal(want.Until) || ban.Reason != want.Reason {
+			t.Errorf("ban mismatch: have %v, want %v", ban, want)
+		}
+	}
+	if err := db.deleteBan(bans[1]); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if stored := db.bans(); len(stored) != 1 || stored[0].IsIPBan() {
+		t.Errorf("wrong bans after deletion: %v", stored)
+	}
+}
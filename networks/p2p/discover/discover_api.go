@@ -18,6 +18,7 @@ package discover
 
 import (
 	"errors"
+	"time"
 )
 
 func (tab *Table) Name() string { return "TableDiscovery" }
@@ -79,32 +80,157 @@ func (tab *Table) DeleteNodeFromTable(n *Node) error {
 	return nil
 }
 
+// GetAuthorizedNodes returns the authorized nodes which are not expired.
 func (tab *Table) GetAuthorizedNodes() []*Node {
+	var ret []*Node
+	for _, n := range tab.ListAuthorizedNodes() {
+		ret = append(ret, n.Node)
+	}
+	return ret
+}
+
+// ListAuthorizedNodes returns the entries of the authorized nodes which are not expired.
+func (tab *Table) ListAuthorizedNodes() []*AuthorizedNode {
 	tab.storagesMu.RLock()
 	defer tab.storagesMu.RUnlock()
-	var ret []*Node
+	var ret []*AuthorizedNode
 	for _, storage := range tab.storages {
 		ret = append(ret, storage.getAuthorizedNodes()...)
 	}
 	return ret
 }
 
+// PutAuthorizedNodes authorizes the nodes without expiry.
 func (tab *Table) PutAuthorizedNodes(nodes []*Node) {
+	now := time.Now()
+	entries := make([]*AuthorizedNode, 0, len(nodes))
+	for _, node := range nodes {
+		entries = append(entries, &AuthorizedNode{Node: node, AddedAt: now})
+	}
+	tab.PutAuthorizedNodeEntries(entries)
+}
+
+// PutAuthorizedNodeEntries authorizes the nodes of the entries, and stores them in the
+// peer database. The nodes of a node type without storage are ignored.
+func (tab *Table) PutAuthorizedNodeEntries(nodes []*AuthorizedNode) {
 	tab.storagesMu.RLock()
 	defer tab.storagesMu.RUnlock()
 	for _, node := range nodes {
-		if tab.storages[node.NType] != nil {
-			tab.storages[node.NType].putAuthorizedNode(node)
+		storage := tab.storages[node.Node.NType]
+		if storage == nil {
+			tab.localLogger.Debug("No storage for the authorized node", "nodeid", node.Node.ID, "nodetype", nodeTypeName(node.Node.NType))
+			continue
+		}
+		if err := tab.db.updateAuthorizedNode(node); err != nil {
+			tab.localLogger.Error("Failed to store an authorized node", "nodeid", node.Node.ID, "err", err)
 		}
+		if err := tab.db.restrictAuthorizedNodes(node.Node.NType); err != nil {
+			tab.localLogger.Error("Failed to store the restriction of authorized nodes", "nodetype", nodeTypeName(node.Node.NType), "err", err)
+		}
+		tab.auditAuthorizedNode(AuthorizedNodeActionPut, node.Node)
+		storage.putAuthorizedNode(node)
 	}
 }
 
+// DeleteAuthorizedNodes removes the nodes from the authorized nodes and the peer database.
+// The deleted nodes are not authorized again by the configuration after a restart, and
+// the node types stay restricted to the authorized nodes.
 func (tab *Table) DeleteAuthorizedNodes(nodes []*Node) {
 	tab.storagesMu.RLock()
 	defer tab.storagesMu.RUnlock()
 	for _, node := range nodes {
 		if tab.storages[node.NType] != nil {
-			tab.storages[node.NType].deleteAuthorizedNode(node.ID)
+			tab.deleteAuthorizedNode(AuthorizedNodeActionDelete, node)
+		}
+	}
+}
+
+// GetAuthorizedNodeAudits returns at most max records of the changes of the authorized
+// nodes, the latest first. It returns all the records if max is not positive.
+func (tab *Table) GetAuthorizedNodeAudits(max int) []*AuthorizedNodeAudit {
+	return tab.db.authorizedNodeAudits(max)
+}
+
+// loadAuthorizedNodes loads the authorized nodes stored in the peer database, and
+// adds the configured nodes which are neither stored yet nor deleted through the API.
+// The node types which were restricted stay restricted even if all of their nodes
+// have been removed.
+func (tab *Table) loadAuthorizedNodes(configured []*Node) {
+	stored := make(map[NodeID]bool)
+	tab.storagesMu.RLock()
+	for nType, storage := range tab.storages {
+		if tab.db.authorizedNodesRestricted(nType) {
+			storage.restrictAuthorizedNodes()
+		}
+	}
+	for _, node := range tab.db.authorizedNodes() {
+		if storage := tab.storages[node.Node.NType]; storage != nil {
+			storage.putAuthorizedNode(node)
+			stored[node.Node.ID] = true
+		}
+	}
+	tab.storagesMu.RUnlock()
+
+	var nodes []*Node
+	for _, node := range configured {
+		if !stored[node.ID] && !tab.db.authorizedNodeDeleted(node.NType, node.ID) {
+			nodes = append(nodes, node)
+		}
+	}
+	tab.PutAuthorizedNodes(nodes)
+}
+
+// expireAuthorizedNodes removes the expired authorized nodes. The node types of the
+// expired nodes stay restricted to the authorized nodes.
+func (tab *Table) expireAuthorizedNodes() {
+	tab.storagesMu.RLock()
+	defer tab.storagesMu.RUnlock()
+	now := time.Now()
+	for _, node := range tab.db.authorizedNodes() {
+		if node.expired(now) && tab.storages[node.Node.NType] != nil {
+			tab.deleteAuthorizedNode(AuthorizedNodeActionExpire, node.Node)
 		}
 	}
 }
+
+// deleteAuthorizedNode removes the node from the authorized nodes and the peer database.
+// The node deleted explicitly is recorded, so that the configuration does not add it
+// again. The caller must hold tab.storagesMu.
+func (tab *Table) deleteAuthorizedNode(action string, node *Node) {
+	if !tab.db.hasAuthorizedNode(node.NType, node.ID) {
+		tab.localLogger.Debug("No authorized node to be deleted", "nodeid", node.ID, "nodetype", nodeTypeName(node.NType))
+		return
+	}
+	if action == AuthorizedNodeActionDelete {
+		if err := tab.db.markAuthorizedNodeDeleted(node.NType, node.ID); err != nil {
+			tab.localLogger.Error("Failed to record the deletion of an authorized node", "nodeid", node.ID, "err", err)
+		}
+	}
+	if err := tab.db.deleteAuthorizedNode(node.NType, node.ID); err != nil {
+		tab.localLogger.Error("Failed to delete an authorized node", "nodeid", node.ID, "err", err)
+	}
+	tab.auditAuthorizedNode(action, node)
+	tab.storages[node.NType].deleteAuthorizedNode(node.ID)
+}
+
+func (tab *Table) auditAuthorizedNode(action string, node *Node) {
+	audit := &AuthorizedNodeAudit{Time: time.Now(), Action: action, ID: node.ID, Role: nodeTypeName(node.NType)}
+	if err := tab.db.addAuthorizedNodeAudit(audit); err != nil {
+		tab.localLogger.Error("Failed to store an audit record of authorized nodes", "nodeid", node.ID, "err", err)
+	}
+}
+
+// GetBans returns the bans stored in the peer database, including expired ones.
+func (tab *Table) GetBans() []*Ban {
+	return tab.db.bans()
+}
+
+// PutBan stores the ban in the peer database.
+func (tab *Table) PutBan(ban *Ban) error {
+	return tab.db.updateBan(ban)
+}
+
+// DeleteBan deletes the ban from the peer database.
+func (tab *Table) DeleteBan(ban *Ban) error {
+	return tab.db.deleteBan(ban)
+}
@@ -58,11 +58,12 @@ func (mds *mockDiscoveryStorage) getNodes(max int) []*Node { return nil }
 func (mds *mockDiscoveryStorage) doRevalidate()            {}
 func (mds *mockDiscoveryStorage) doRefresh()               {}
 
-func (mds *mockDiscoveryStorage) isAuthorized(id NodeID) bool    { return true }
-func (mds *mockDiscoveryStorage) getBucketEntries() []*Node      { return mds.data }
-func (mds *mockDiscoveryStorage) getAuthorizedNodes() []*Node    { return nil }
-func (mds *mockDiscoveryStorage) putAuthorizedNode(node *Node)   {}
-func (mds *mockDiscoveryStorage) deleteAuthorizedNode(id NodeID) {}
+func (mds *mockDiscoveryStorage) isAuthorized(id NodeID) bool            { return true }
+func (mds *mockDiscoveryStorage) getBucketEntries() []*Node              { return mds.data }
+func (mds *mockDiscoveryStorage) getAuthorizedNodes() []*AuthorizedNode  { return nil }
+func (mds *mockDiscoveryStorage) putAuthorizedNode(node *AuthorizedNode) {}
+func (mds *mockDiscoveryStorage) deleteAuthorizedNode(id NodeID)         {}
+func (mds *mockDiscoveryStorage) restrictAuthorizedNodes()               {}
 
 func createTestData() *mockDiscoveryStorage {
 	var d []*Node
@@ -44,9 +44,10 @@ type discoverStorage interface {
 
 	// API
 	getBucketEntries() []*Node
-	getAuthorizedNodes() []*Node
-	putAuthorizedNode(node *Node)
+	getAuthorizedNodes() []*AuthorizedNode
+	putAuthorizedNode(node *AuthorizedNode)
 	deleteAuthorizedNode(id NodeID)
+	restrictAuthorizedNodes()
 }
 
 // pushNode adds n to the front of list, keeping at most max items.
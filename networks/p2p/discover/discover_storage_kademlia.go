@@ -51,6 +51,8 @@ type KademliaStorage struct {
 	ips         netutil.DistinctNetSet
 	noDiscover  bool // if noDiscover is true, doesn't lookup new node.
 	localLogger log.Logger
+
+	authorizedNodes authorizedNodeSet
 }
 
 func (s *KademliaStorage) init() {
@@ -447,7 +449,25 @@ func (b *bucket) bump(n *Node) bool {
 	return false
 }
 
-func (s *KademliaStorage) isAuthorized(id NodeID) bool { return true }
-func (s *KademliaStorage) getAuthorizedNodes() []*Node { return nil }
-func (s *KademliaStorage) putAuthorizedNode(*Node)     {}
-func (s *KademliaStorage) deleteAuthorizedNode(NodeID) {}
+func (s *KademliaStorage) isAuthorized(id NodeID) bool {
+	return s.authorizedNodes.isAuthorized(id)
+}
+
+func (s *KademliaStorage) getAuthorizedNodes() []*AuthorizedNode {
+	return s.authorizedNodes.list()
+}
+
+func (s *KademliaStorage) putAuthorizedNode(node *AuthorizedNode) {
+	if node.Node.NType != s.targetType {
+		return
+	}
+	s.authorizedNodes.put(node)
+}
+
+func (s *KademliaStorage) deleteAuthorizedNode(id NodeID) {
+	s.authorizedNodes.delete(id)
+}
+
+func (s *KademliaStorage) restrictAuthorizedNodes() {
+	s.authorizedNodes.restrict()
+}
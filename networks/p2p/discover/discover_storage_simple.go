@@ -36,20 +36,7 @@ type simpleStorage struct {
 	rand        *rand.Rand
 	localLogger log.Logger
 
-	lock               sync.RWMutex
-	hasAuthorizedNodes bool
-	authorizedNodes    map[NodeID]*Node
-}
-
-func NewSimpleStorage(nodeType NodeType, noDiscover bool, max int, authorizedNodes []*Node) *simpleStorage {
-	storage := &simpleStorage{targetType: nodeType, noDiscover: noDiscover, max: max}
-	storage.authorizedNodes = make(map[NodeID]*Node)
-	for _, node := range authorizedNodes {
-		if node.NType == nodeType {
-			storage.putAuthorizedNode(node)
-		}
-	}
-	return storage
+	authorizedNodes authorizedNodeSet
 }
 
 func (s *simpleStorage) init() {
@@ -276,46 +263,24 @@ func (s *simpleStorage) name() string {
 }
 
 func (s *simpleStorage) isAuthorized(id NodeID) bool {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	if !s.hasAuthorizedNodes {
-		return true
-	}
-	for _, n := range s.authorizedNodes {
-		if n.ID == id {
-			return true
-		}
-	}
-	return false
+	return s.authorizedNodes.isAuthorized(id)
 }
 
-func (s *simpleStorage) getAuthorizedNodes() []*Node {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	var ret []*Node
-	for _, val := range s.authorizedNodes {
-		ret = append(ret, val)
-	}
-	return ret
+func (s *simpleStorage) getAuthorizedNodes() []*AuthorizedNode {
+	return s.authorizedNodes.list()
 }
 
-func (s *simpleStorage) putAuthorizedNode(node *Node) {
-	if node.NType != s.targetType {
+func (s *simpleStorage) putAuthorizedNode(node *AuthorizedNode) {
+	if node.Node.NType != s.targetType {
 		return
 	}
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	s.authorizedNodes[node.ID] = node
-	s.hasAuthorizedNodes = true
+	s.authorizedNodes.put(node)
 }
 
 func (s *simpleStorage) deleteAuthorizedNode(id NodeID) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	if _, ok := s.authorizedNodes[id]; ok {
-		delete(s.authorizedNodes, id)
-		s.hasAuthorizedNodes = len(s.authorizedNodes) != 0
-	} else {
-		logger.Debug("No node to be removed", "nodeid", id)
-	}
+	s.authorizedNodes.delete(id)
+}
+
+func (s *simpleStorage) restrictAuthorizedNodes() {
+	s.authorizedNodes.restrict()
 }
@@ -49,6 +49,8 @@ const (
 	revalidateInterval = 10 * time.Second
 	copyNodesInterval  = 30 * time.Second
 
+	authorizedNodeExpiryInterval = time.Minute // Time period for removing expired authorized nodes
+
 	seedCount  = 30
 	seedMaxAge = 5 * 24 * time.Hour
 )
@@ -81,6 +83,13 @@ type Discovery interface {
 	GetAuthorizedNodes() []*Node
 	PutAuthorizedNodes(nodes []*Node)
 	DeleteAuthorizedNodes(nodes []*Node)
+	ListAuthorizedNodes() []*AuthorizedNode
+	PutAuthorizedNodeEntries(nodes []*AuthorizedNode)
+	GetAuthorizedNodeAudits(max int) []*AuthorizedNodeAudit
+
+	GetBans() []*Ban
+	PutBan(ban *Ban) error
+	DeleteBan(ban *Ban) error
 }
 
 type Table struct {
@@ -161,12 +170,14 @@ func newTable(cfg *Config) (Discovery, error) {
 		tab.addStorage(NodeTypeEN, &KademliaStorage{targetType: NodeTypeEN})
 		tab.addStorage(NodeTypeBN, &simpleStorage{targetType: NodeTypeBN, noDiscover: true, max: 3})
 	case NodeTypeBN:
-		tab.addStorage(NodeTypeCN, NewSimpleStorage(NodeTypeCN, true, 100, cfg.AuthorizedNodes))
-		tab.addStorage(NodeTypePN, NewSimpleStorage(NodeTypePN, true, 100, cfg.AuthorizedNodes))
+		tab.addStorage(NodeTypeCN, &simpleStorage{targetType: NodeTypeCN, noDiscover: true, max: 100})
+		tab.addStorage(NodeTypePN, &simpleStorage{targetType: NodeTypePN, noDiscover: true, max: 100})
 		tab.addStorage(NodeTypeEN, &KademliaStorage{targetType: NodeTypeEN, noDiscover: true})
 		tab.addStorage(NodeTypeBN, &simpleStorage{targetType: NodeTypeBN, max: 3})
 	}
 
+	tab.loadAuthorizedNodes(cfg.AuthorizedNodes)
+
 	if err := tab.setFallbackNodes(cfg.Bootnodes); err != nil {
 		return nil, err
 	}
@@ -429,6 +440,7 @@ func (tab *Table) loop() {
 		revalidate     = time.NewTimer(tab.nextRevalidateTime())
 		refresh        = time.NewTicker(refreshInterval)
 		copyNodes      = time.NewTicker(copyNodesInterval)
+		expireAuth     = time.NewTicker(authorizedNodeExpiryInterval)
 		revalidateDone = make(chan struct{})
 		refreshDone    = make(chan struct{})           // where doRefresh reports completion
 		waiting        = []chan struct{}{tab.initDone} // holds waiting callers while doRefresh runs
@@ -436,6 +448,7 @@ func (tab *Table) loop() {
 	defer refresh.Stop()
 	defer revalidate.Stop()
 	defer copyNodes.Stop()
+	defer expireAuth.Stop()
 
 	// Start initial refresh.
 	go tab.doRefresh(refreshDone)
@@ -467,6 +480,8 @@ loop:
 			revalidate.Reset(tt)
 		case <-copyNodes.C:
 			go tab.copyBondedNodes()
+		case <-expireAuth.C:
+			tab.expireAuthorizedNodes()
 		case <-tab.closeReq:
 			break loop
 		}
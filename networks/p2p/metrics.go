@@ -45,6 +45,14 @@ var (
 	connectionInCountGauge  = metrics.NewRegisteredGauge("p2p/ConnectionInCountGauge", nil)
 	connectionOutCountGauge = metrics.NewRegisteredGauge("p2p/ConnectionOutCountGauge", nil)
 
+	penalizedPeerMeter      = metrics.NewRegisteredMeter("p2p/PenalizedPeers", nil)
+	bannedPeerMeter         = metrics.NewRegisteredMeter("p2p/BannedPeers", nil)
+	rejectedBannedConnMeter = metrics.NewRegisteredMeter("p2p/RejectedBannedConns", nil)
+
+	rateLimitInboundBytesMeter = metrics.NewRegisteredMeter("p2p/RateLimit/InboundBytes", nil)
+	rateLimitDelayedMsgMeter   = metrics.NewRegisteredMeter("p2p/RateLimit/DelayedMsgs", nil)
+	rateLimitDroppedMsgMeter   = metrics.NewRegisteredMeter("p2p/RateLimit/DroppedMsgs", nil)
+
 	dialTryCounter  = metrics.NewRegisteredCounter("p2p/DialTryCounter", nil)
 	dialFailCounter = metrics.NewRegisteredCounter("p2p/DialFailCounter", nil)
 
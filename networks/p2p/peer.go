@@ -122,6 +122,12 @@ type Peer struct {
 
 	// events receives message send / receive events if set
 	events *event.Feed
+
+	// scorer keeps the penalty score of the peer if set
+	scorer *peerScorer
+
+	// limiter limits the inbound traffic of the peer if set
+	limiter *RateLimiter
 }
 
 // NewPeer returns a peer for testing purposes.
@@ -168,6 +174,29 @@ func (p *Peer) Disconnect(reason DiscReason) {
 	}
 }
 
+// Penalize adds the penalty to the score of the peer, and disconnects the peer
+// if it gets banned. Trusted peers and consensus nodes are never penalized.
+func (p *Peer) Penalize(penalty Penalty, reason string) {
+	if p.scorer == nil || p.ConnType() == CONSENSUSNODE {
+		return
+	}
+	for _, rw := range p.rws {
+		if rw.is(trustedConn) {
+			return
+		}
+	}
+	p.logger.Debug("Penalizing peer", "penalty", penalty, "reason", reason)
+	if ban := p.scorer.penalize(p.ID(), remoteIP(p.rws[ConnDefault].fd), penalty, reason); ban != nil {
+		p.Disconnect(DiscUselessPeer)
+	}
+}
+
+// RateLimiter returns the rate limiter of the inbound traffic of the peer.
+// It returns nil, which limits nothing, if the peer is not run by a server.
+func (p *Peer) RateLimiter() *RateLimiter {
+	return p.limiter
+}
+
 // String implements fmt.Stringer.
 func (p *Peer) String() string {
 	return fmt.Sprintf("Peer %x %v", p.rws[ConnDefault].id[:8], p.RemoteAddr())
@@ -647,6 +676,8 @@ type PeerInfo struct {
 	Caps      []string               `json:"caps"`      // Sum-protocols advertised by this particular peer
 	Networks  []NetworkInfo          `json:"networks"`  // Networks is all the NetworkInfo associated with the peer
 	Protocols map[string]interface{} `json:"protocols"` // Sub-protocol specific metadata fields
+	Score     float64                `json:"score"`     // Penalty score of the peer, which decays over time
+	Usage     *RateLimitUsage        `json:"usage"`     // Inbound traffic of the peer since it is connected
 }
 
 // Info gathers and returns a collection of metadata known about a peer.
@@ -663,6 +694,10 @@ func (p *Peer) Info() *PeerInfo {
 		Caps:      caps,
 		Protocols: make(map[string]interface{}),
 	}
+	if p.scorer != nil {
+		info.Score = p.scorer.score(p.ID())
+	}
+	info.Usage = p.limiter.Usage()
 
 	for _, rw := range p.rws {
 		var network NetworkInfo
@@ -671,18 +706,7 @@ func (p *Peer) Info() *PeerInfo {
 		network.Inbound = rw.is(inboundConn)
 		network.Trusted = rw.is(trustedConn)
 		network.Static = rw.is(staticDialedConn)
-		switch rw.conntype {
-		case CONSENSUSNODE:
-			network.NodeType = "cn"
-		case ENDPOINTNODE:
-			network.NodeType = "en"
-		case PROXYNODE:
-			network.NodeType = "pn"
-		case BOOTNODE:
-			network.NodeType = "bn"
-		default:
-			network.NodeType = "unknown"
-		}
+		network.NodeType = connTypeName(rw.conntype)
 		info.Networks = append(info.Networks, network)
 	}
 
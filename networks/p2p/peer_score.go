@@ -0,0 +1,318 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"fmt"
+	"github.com/klaytn/klaytn/networks/p2p/discover"
+	"math"
+	"net"
+	"sync"
+	"time"
+)
+
+// Penalty is the amount of penalty points given to a misbehaving peer.
+type Penalty int
+
+// Penalties given by the protocol handlers.
+const (
+	PenaltyUselessPeer   Penalty = 10 // The peer has nothing we need
+	PenaltyTimeout       Penalty = 20 // The peer did not respond in time
+	PenaltyProtocolError Penalty = 40 // The peer sent an invalid message
+	PenaltyBadBlock      Penalty = 50 // The peer sent an invalid block, which an honest peer may also relay
+)
+
+const (
+	// banThreshold is the score of a node ID which bans it.
+	banThreshold = 100
+	// ipBanThreshold is the score of an IP address which bans it. It is higher than
+	// banThreshold since several nodes may share an IP address.
+	ipBanThreshold = 3 * banThreshold
+	// scoreHalfLife is the time it takes for a score to decay by half.
+	scoreHalfLife = 10 * time.Minute
+	// defaultBanDuration is how long a peer is banned when its score reaches the threshold.
+	defaultBanDuration = time.Hour
+
+	// maxScores is the number of the scores kept before the decayed ones are pruned.
+	maxScores = 1024
+	// minScore is the score under which a score is pruned.
+	minScore = 1
+)
+
+// peerScore is a score which decays exponentially over time.
+type peerScore struct {
+	value   float64
+	updated time.Time
+}
+
+// decayed returns the value of the score at the given time.
+func (s *peerScore) decayed(now time.Time) float64 {
+	elapsed := now.Sub(s.updated)
+	if elapsed <= 0 {
+		return s.value
+	}
+	return s.value * math.Pow(0.5, float64(elapsed)/float64(scoreHalfLife))
+}
+
+// add adds points to the score and returns the new value.
+func (s *peerScore) add(points float64, now time.Time) float64 {
+	s.value = s.decayed(now) + points
+	s.updated = now
+	return s.value
+}
+
+// peerScorer keeps the penalty scores of node IDs and IP addresses, and bans them
+// when their scores reach the thresholds. The bans are stored in the node database.
+type peerScorer struct {
+	lock     sync.Mutex
+	scores   map[discover.NodeID]*peerScore
+	ipScores map[string]*peerScore
+	bans     map[discover.NodeID]*discover.Ban
+	ipBans   map[string]*discover.Ban
+	db       banStore // nil if the bans are not stored
+
+	now func() time.Time // replaced in tests
+}
+
+// banStore is the storage of the bans, which is the node table or the node database
+// opened when the discovery is disabled.
+type banStore interface {
+	GetBans() []*discover.Ban
+	PutBan(ban *discover.Ban) error
+	DeleteBan(ban *discover.Ban) error
+}
+
+func newPeerScorer(db banStore) *peerScorer {
+	s := &peerScorer{
+		scores:   make(map[discover.NodeID]*peerScore),
+		ipScores: make(map[string]*peerScore),
+		bans:     make(map[discover.NodeID]*discover.Ban),
+		ipBans:   make(map[string]*discover.Ban),
+		db:       db,
+		now:      time.Now,
+	}
+	if db != nil {
+		now := s.now()
+		for _, ban := range db.GetBans() {
+			if ban.Expired(now) {
+				s.deleteStoredBan(ban)
+				continue
+			}
+			s.setBan(ban)
+		}
+	}
+	return s
+}
+
+// penalize adds the penalty to the scores of the node ID and the IP address.
+// It returns the ban if any of them is banned by the penalty.
+func (s *peerScorer) penalize(id discover.NodeID, ip net.IP, penalty Penalty, reason string) *discover.Ban {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	penalizedPeerMeter.Mark(1)
+	score, ok := s.scores[id]
+	if !ok {
+		s.prune(now)
+		score = &peerScore{}
+		s.scores[id] = score
+	}
+	var ban *discover.Ban
+	if score.add(float64(penalty), now) >= banThreshold {
+		ban = &discover.Ban{ID: id, Until: now.Add(defaultBanDuration), Reason: reason}
+		s.addBan(ban)
+		delete(s.scores, id)
+	}
+	if ip != nil {
+		ipScore, ok := s.ipScores[ip.String()]
+		if !ok {
+			ipScore = &peerScore{}
+			s.ipScores[ip.String()] = ipScore
+		}
+		if ipScore.add(float64(penalty), now) >= ipBanThreshold {
+			ban = &discover.Ban{IP: ip, Until: now.Add(defaultBanDuration), Reason: reason}
+			s.addBan(ban)
+			delete(s.ipScores, ip.String())
+		}
+	}
+	return ban
+}
+
+// prune removes the scores which have decayed enough, if there are too many scores.
+func (s *peerScorer) prune(now time.Time) {
+	if len(s.scores) < maxScores && len(s.ipScores) < maxScores {
+		return
+	}
+	for id, score := range s.scores {
+		if score.decayed(now) < minScore {
+			delete(s.scores, id)
+		}
+	}
+	for ip, score := range s.ipScores {
+		if score.decayed(now) < minScore {
+			delete(s.ipScores, ip)
+		}
+	}
+}
+
+// score returns the current score of the node ID.
+func (s *peerScorer) score(id discover.NodeID) float64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if score, ok := s.scores[id]; ok {
+		return score.decayed(s.now())
+	}
+	return 0
+}
+
+// banned returns the ban of the node ID or the IP address, or nil if neither is banned.
+func (s *peerScorer) banned(id discover.NodeID, ip net.IP) *discover.Ban {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	if ban, ok := s.bans[id]; ok {
+		if !ban.Expired(now) {
+			return ban
+		}
+		s.removeBan(ban)
+	}
+	if ip == nil {
+		return nil
+	}
+	if ban, ok := s.ipBans[ip.String()]; ok {
+		if !ban.Expired(now) {
+			return ban
+		}
+		s.removeBan(ban)
+	}
+	return nil
+}
+
+// ban bans the node ID or the IP address of the ban, replacing the previous ban.
+func (s *peerScorer) ban(ban *discover.Ban) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.addBan(ban)
+}
+
+// unban lifts the ban of the node ID or the IP address of the ban, and resets its score.
+// It returns false if there is no such ban.
+func (s *peerScorer) unban(ban *discover.Ban) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		old *discover.Ban
+		ok  bool
+	)
+	if ban.IsIPBan() {
+		old, ok = s.ipBans[ban.IP.String()]
+		delete(s.ipScores, ban.IP.String())
+	} else {
+		old, ok = s.bans[ban.ID]
+		delete(s.scores, ban.ID)
+	}
+	if ok {
+		s.removeBan(old)
+	}
+	return ok
+}
+
+// list returns the bans which have not expired.
+func (s *peerScorer) list() []*discover.Ban {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	bans := make([]*discover.Ban, 0, len(s.bans)+len(s.ipBans))
+	for _, ban := range s.bans {
+		if !ban.Expired(now) {
+			bans = append(bans, ban)
+		}
+	}
+	for _, ban := range s.ipBans {
+		if !ban.Expired(now) {
+			bans = append(bans, ban)
+		}
+	}
+	return bans
+}
+
+func (s *peerScorer) setBan(ban *discover.Ban) {
+	if ban.IsIPBan() {
+		s.ipBans[ban.IP.String()] = ban
+	} else {
+		s.bans[ban.ID] = ban
+	}
+}
+
+func (s *peerScorer) addBan(ban *discover.Ban) {
+	logger.Info("Banning peer", "id", ban.ID, "ip", ban.IP, "until", ban.Until, "reason", ban.Reason)
+	bannedPeerMeter.Mark(1)
+	s.setBan(ban)
+	if s.db != nil {
+		if err := s.db.PutBan(ban); err != nil {
+			logger.Error("Failed to store the ban", "id", ban.ID, "ip", ban.IP, "err", err)
+		}
+	}
+}
+
+func (s *peerScorer) removeBan(ban *discover.Ban) {
+	logger.Info("Unbanning peer", "id", ban.ID, "ip", ban.IP)
+	if ban.IsIPBan() {
+		delete(s.ipBans, ban.IP.String())
+	} else {
+		delete(s.bans, ban.ID)
+	}
+	s.deleteStoredBan(ban)
+}
+
+func (s *peerScorer) deleteStoredBan(ban *discover.Ban) {
+	if s.db != nil {
+		if err := s.db.DeleteBan(ban); err != nil {
+			logger.Error("Failed to delete the ban", "id", ban.ID, "ip", ban.IP, "err", err)
+		}
+	}
+}
+
+// ParseBanTarget parses the target of a ban, which is a kni URL, a node ID or an IP address.
+// The ban returned has only the node ID or the IP address set.
+func ParseBanTarget(target string) (*discover.Ban, error) {
+	if ip := net.ParseIP(target); ip != nil {
+		return &discover.Ban{IP: ip}, nil
+	}
+	if id, err := discover.HexID(target); err == nil {
+		return &discover.Ban{ID: id}, nil
+	}
+	node, err := discover.ParseNode(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ban target %q: %v", target, err)
+	}
+	return &discover.Ban{ID: node.ID}, nil
+}
+
+// remoteIP returns the IP address of the remote end of the connection, or nil if
+// the connection is not a TCP connection.
+func remoteIP(fd net.Conn) net.IP {
+	if addr, ok := fd.RemoteAddr().(*net.TCPAddr); ok {
+		return addr.IP
+	}
+	return nil
+}
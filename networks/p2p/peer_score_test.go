@@ -0,0 +1,229 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"github.com/klaytn/klaytn/networks/p2p/discover"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// banTable is a discovery table which keeps bans in memory.
+type banTable struct {
+	fakeTable
+	bans map[string]*discover.Ban
+}
+
+func banKey(ban *discover.Ban) string {
+	if ban.IsIPBan() {
+		return ban.IP.String()
+	}
+	return ban.ID.String()
+}
+
+func (t *banTable) GetBans() []*discover.Ban {
+	var bans []*discover.Ban
+	for _, ban := range t.bans {
+		bans = append(bans, ban)
+	}
+	return bans
+}
+
+func (t *banTable) PutBan(ban *discover.Ban) error {
+	t.bans[banKey(ban)] = ban
+	return nil
+}
+
+func (t *banTable) DeleteBan(ban *discover.Ban) error {
+	delete(t.bans, banKey(ban))
+	return nil
+}
+
+func TestPeerScorer(t *testing.T) {
+	var (
+		table = &banTable{bans: make(map[string]*discover.Ban)}
+		s     = newPeerScorer(table)
+		now   = time.Now()
+		ip    = net.ParseIP("10.0.0.1")
+		id    = randomID()
+	)
+	s.now = func() time.Time { return now }
+
+	// Scores decay by half every half-life.
+	assert.Nil(t, s.penalize(id, ip, PenaltyProtocolError, "test"))
+	now = now.Add(scoreHalfLife)
+	assert.InDelta(t, float64(PenaltyProtocolError)/2, s.score(id), 0.001)
+
+	// The node ID is banned when its score reaches the threshold.
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, s.penalize(id, ip, PenaltyTimeout, "test"))
+	}
+	ban := s.penalize(id, ip, PenaltyTimeout, "test")
+	if assert.NotNil(t, ban) {
+		assert.Equal(t, id, ban.ID)
+		assert.False(t, ban.IsIPBan())
+	}
+	assert.Equal(t, ban, s.banned(id, nil))
+	assert.Nil(t, s.banned(randomID(), ip))
+	assert.Equal(t, 0.0, s.score(id))
+
+	// A node ID is not banned by a single bad block.
+	id2 := randomID()
+	assert.Nil(t, s.penalize(id2, ip, PenaltyBadBlock, "test"))
+	ban = s.penalize(id2, ip, PenaltyBadBlock, "test")
+	if assert.NotNil(t, ban) {
+		assert.False(t, ban.IsIPBan())
+	}
+
+	// The IP address is banned when the scores of the nodes sharing it reach the threshold.
+	assert.Nil(t, s.penalize(randomID(), ip, PenaltyBadBlock, "test"))
+	assert.Nil(t, s.banned(randomID(), ip))
+	ban = s.penalize(randomID(), ip, PenaltyBadBlock, "test")
+	if assert.NotNil(t, ban) {
+		assert.True(t, ban.IsIPBan())
+	}
+	assert.NotNil(t, s.banned(randomID(), ip))
+	assert.Equal(t, 3, len(s.list())) // Two node IDs and the IP address
+
+	// The bans are loaded from the database.
+	s = newPeerScorer(table)
+	s.now = func() time.Time { return now }
+	assert.NotNil(t, s.banned(id, nil))
+	assert.NotNil(t, s.banned(randomID(), ip))
+
+	// The bans can be lifted, and expire after the ban duration.
+	assert.True(t, s.unban(&discover.Ban{IP: ip}))
+	assert.False(t, s.unban(&discover.Ban{IP: ip}))
+	assert.Nil(t, s.banned(randomID(), ip))
+	now = now.Add(defaultBanDuration)
+	assert.Nil(t, s.banned(id, nil))
+	assert.Equal(t, 1, len(table.bans))
+}
+
+func TestPeerPenalize(t *testing.T) {
+	s := newPeerScorer(&banTable{bans: make(map[string]*discover.Ban)})
+	newpeer := func(connType ConnType) *Peer {
+		fd, _ := net.Pipe()
+		return &Peer{rws: []*conn{{fd: fd, conntype: connType, id: randomID()}}, scorer: s, logger: logger}
+	}
+
+	// Consensus nodes are never penalized.
+	cn := newpeer(CONSENSUSNODE)
+	cn.Penalize(PenaltyBadBlock, "test")
+	assert.Equal(t, 0.0, s.score(cn.ID()))
+
+	en := newpeer(ENDPOINTNODE)
+	en.Penalize(PenaltyBadBlock, "test")
+	assert.InDelta(t, float64(PenaltyBadBlock), s.score(en.ID()), 0.001)
+}
+
+func TestServerBannedPeer(t *testing.T) {
+	trustedID := randomID()
+	srv := &SingleChannelServer{
+		BaseServer: &BaseServer{
+			Config: Config{
+				PrivateKey:             newkey(),
+				MaxPhysicalConnections: 10,
+				NoDial:                 true,
+				TrustedNodes:           []*discover.Node{{ID: trustedID}},
+			},
+		},
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+	defer srv.Stop()
+
+	newconn := func(id discover.NodeID) *conn {
+		fd, _ := net.Pipe()
+		tx := newTestTransport(id, fd)
+		return &conn{fd: fd, transport: tx, flags: inboundConn, conntype: ConnTypeUndefined, id: id, cont: make(chan error)}
+	}
+
+	bannedID := randomID()
+	until := time.Now().Add(time.Hour)
+	assert.NoError(t, srv.BanPeer(&discover.Ban{ID: bannedID, Until: until}))
+	assert.NoError(t, srv.BanPeer(&discover.Ban{ID: trustedID, Until: until}))
+	assert.Equal(t, 2, len(srv.BannedPeers()))
+
+	// Banned connections are rejected unless they are trusted.
+	assert.Equal(t, DiscUselessPeer, srv.checkpoint(newconn(bannedID), srv.posthandshake))
+	assert.NoError(t, srv.checkpoint(newconn(trustedID), srv.posthandshake))
+
+	unbanned, err := srv.UnbanPeer(&discover.Ban{ID: bannedID})
+	assert.NoError(t, err)
+	assert.True(t, unbanned)
+	assert.NoError(t, srv.checkpoint(newconn(bannedID), srv.posthandshake))
+}
+
+// TestServerBannedPeerNoDiscovery checks that the bans are stored in the node database
+// even if the discovery is disabled.
+func TestServerBannedPeerNoDiscovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "banned-peers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := Config{
+		PrivateKey:             newkey(),
+		MaxPhysicalConnections: 10,
+		NoDiscovery:            true,
+		NoDial:                 true,
+		NodeDatabase:           filepath.Join(dir, "nodes"),
+	}
+	srv := &SingleChannelServer{BaseServer: &BaseServer{Config: config}}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+	bannedID := randomID()
+	assert.NoError(t, srv.BanPeer(&discover.Ban{ID: bannedID, Until: time.Now().Add(time.Hour)}))
+	srv.Stop()
+
+	// The ban is loaded again after a restart.
+	srv = &SingleChannelServer{BaseServer: &BaseServer{Config: config}}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+	defer srv.Stop()
+	if bans := srv.BannedPeers(); assert.Equal(t, 1, len(bans)) {
+		assert.Equal(t, bannedID, bans[0].ID)
+	}
+}
+
+func TestParseBanTarget(t *testing.T) {
+	id := randomID()
+	ban, err := ParseBanTarget("10.0.0.1")
+	if assert.NoError(t, err) {
+		assert.True(t, ban.IsIPBan())
+	}
+	ban, err = ParseBanTarget(id.String())
+	if assert.NoError(t, err) {
+		assert.Equal(t, id, ban.ID)
+	}
+	ban, err = ParseBanTarget((&discover.Node{ID: id, IP: net.ParseIP("10.0.0.1"), TCP: 32323}).String())
+	if assert.NoError(t, err) {
+		assert.Equal(t, id, ban.ID)
+	}
+	_, err = ParseBanTarget("invalid")
+	assert.Error(t, err)
+}
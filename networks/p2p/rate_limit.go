@@ -0,0 +1,330 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"fmt"
+	"github.com/klaytn/klaytn/metrics"
+	"github.com/klaytn/klaytn/networks/p2p/discover"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is a limit of the inbound traffic of a peer. Zero means no limit.
+type RateLimit struct {
+	Bandwidth    uint64            // Bytes per second
+	MsgRate      uint64            // Messages per second
+	MsgCodeRates map[uint64]uint64 `toml:",omitempty"` // Messages per second by message code
+}
+
+// RateLimitConfig is a configuration of the inbound rate limits of the peers.
+// The limits of a peer are selected by the connection type of the peer.
+type RateLimitConfig struct {
+	CN RateLimit
+	PN RateLimit
+	EN RateLimit
+}
+
+// limitOf returns the limit of the peers of the connection type.
+func (c *RateLimitConfig) limitOf(connType ConnType) RateLimit {
+	switch connType {
+	case CONSENSUSNODE:
+		return c.CN
+	case PROXYNODE:
+		return c.PN
+	case ENDPOINTNODE:
+		return c.EN
+	default:
+		return RateLimit{}
+	}
+}
+
+// ParseRateLimit parses a comma separated list of limits. The limits are
+// "bandwidth=<bytes per second>", "msgrate=<messages per second>" and
+// "msgrate.<message code>=<messages per second>".
+func ParseRateLimit(spec string) (RateLimit, error) {
+	var limit RateLimit
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return RateLimit{}, fmt.Errorf("invalid rate limit %q", field)
+		}
+		value, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return RateLimit{}, fmt.Errorf("invalid rate limit %q: %v", field, err)
+		}
+		switch key := kv[0]; {
+		case key == "bandwidth":
+			limit.Bandwidth = value
+		case key == "msgrate":
+			limit.MsgRate = value
+		case strings.HasPrefix(key, "msgrate."):
+			code, err := strconv.ParseUint(strings.TrimPrefix(key, "msgrate."), 0, 64)
+			if err != nil {
+				return RateLimit{}, fmt.Errorf("invalid message code %q: %v", field, err)
+			}
+			if limit.MsgCodeRates == nil {
+				limit.MsgCodeRates = make(map[uint64]uint64)
+			}
+			limit.MsgCodeRates[code] = value
+		default:
+			return RateLimit{}, fmt.Errorf("unknown rate limit %q", key)
+		}
+	}
+	return limit, nil
+}
+
+// tokenBucket is a token bucket which is refilled at a constant rate up to a
+// second worth of tokens. Tokens can be taken beyond the bucket, which delays
+// the next requests until the debt is paid.
+type tokenBucket struct {
+	rate   float64 // Tokens per second
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate uint64, now time.Time) *tokenBucket {
+	if rate == 0 {
+		return nil
+	}
+	return &tokenBucket{rate: float64(rate), tokens: float64(rate), last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += b.rate * elapsed.Seconds()
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+		b.last = now
+	}
+}
+
+// delay returns how long it takes until n tokens can be taken. A request larger
+// than the bucket can be taken when the bucket is full.
+func (b *tokenBucket) delay(n float64, now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+	b.refill(now)
+	if n > b.rate {
+		n = b.rate
+	}
+	if b.tokens >= n {
+		return 0
+	}
+	return time.Duration((n - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) take(n float64, now time.Time) {
+	if b != nil {
+		b.refill(now)
+		b.tokens -= n
+	}
+}
+
+// RateLimitUsage is the inbound traffic of a peer since it is connected.
+type RateLimitUsage struct {
+	InboundBytes     uint64            `json:"inboundBytes"`
+	InboundMsgs      uint64            `json:"inboundMsgs"`
+	InboundBytesRate float64           `json:"inboundBytesRate"` // Bytes per second for the last minute, zero if the metrics are disabled
+	DelayedMsgs      uint64            `json:"delayedMsgs"`
+	DroppedMsgs      uint64            `json:"droppedMsgs"`
+	MsgCodes         map[uint64]uint64 `json:"msgCodes"` // Number of inbound messages by message code
+}
+
+// RateLimiter limits the inbound traffic of a peer with token buckets of the
+// bandwidth, the message rate and the message rates by message code. Priority
+// messages are never limited, but they take tokens from the buckets so that the
+// other messages are limited more. A nil RateLimiter limits nothing.
+type RateLimiter struct {
+	lock      sync.Mutex
+	waitLock  sync.Mutex // keeps the waiting non-priority messages in order
+	bandwidth *tokenBucket
+	msgs      *tokenBucket
+	codes     map[uint64]*tokenBucket
+	usage     RateLimitUsage
+	closed    <-chan struct{} // closed when the peer is disconnected
+
+	metricPrefix string
+	bytesMeter   metrics.Meter
+	msgsMeter    metrics.Meter
+	delayMeter   metrics.Meter
+	dropMeter    metrics.Meter
+}
+
+func newRateLimiter(limit RateLimit, id discover.NodeID, connType ConnType, closed <-chan struct{}) *RateLimiter {
+	now := time.Now()
+	l := &RateLimiter{
+		bandwidth: newTokenBucket(limit.Bandwidth, now),
+		msgs:      newTokenBucket(limit.MsgRate, now),
+		codes:     make(map[uint64]*tokenBucket, len(limit.MsgCodeRates)),
+		closed:    closed,
+		usage:     RateLimitUsage{MsgCodes: make(map[uint64]uint64)},
+	}
+	for code, rate := range limit.MsgCodeRates {
+		if bucket := newTokenBucket(rate, now); bucket != nil {
+			l.codes[code] = bucket
+		}
+	}
+	l.metricPrefix = fmt.Sprintf("p2p/peers/%s/%x/", connTypeName(connType), id[:8])
+	l.bytesMeter = metrics.GetOrRegisterMeter(l.metricPrefix+"InboundBytes", nil)
+	l.msgsMeter = metrics.GetOrRegisterMeter(l.metricPrefix+"InboundMsgs", nil)
+	l.delayMeter = metrics.GetOrRegisterMeter(l.metricPrefix+"DelayedMsgs", nil)
+	l.dropMeter = metrics.GetOrRegisterMeter(l.metricPrefix+"DroppedMsgs", nil)
+	return l
+}
+
+// delay returns how long the message must wait for the limits. Priority messages never wait.
+func (l *RateLimiter) delay(code uint64, size uint32, priority bool, now time.Time) time.Duration {
+	if priority {
+		return 0
+	}
+	delay := l.bandwidth.delay(float64(size), now)
+	if d := l.msgs.delay(1, now); d > delay {
+		delay = d
+	}
+	if d := l.codes[code].delay(1, now); d > delay {
+		delay = d
+	}
+	return delay
+}
+
+// receive takes the tokens of the message and records its usage.
+func (l *RateLimiter) receive(code uint64, size uint32, now time.Time) {
+	l.bandwidth.take(float64(size), now)
+	l.msgs.take(1, now)
+	l.codes[code].take(1, now)
+
+	l.usage.InboundBytes += uint64(size)
+	l.usage.InboundMsgs++
+	l.usage.MsgCodes[code]++
+	l.bytesMeter.Mark(int64(size))
+	l.msgsMeter.Mark(1)
+	rateLimitInboundBytesMeter.Mark(int64(size))
+}
+
+// Allow returns true and takes the tokens of the message if the message is within
+// the limits. Otherwise the message should be dropped, and it is counted as dropped.
+func (l *RateLimiter) Allow(code uint64, size uint32, priority bool) bool {
+	if l == nil {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if l.delay(code, size, priority, now) > 0 {
+		l.usage.DroppedMsgs++
+		l.dropMeter.Mark(1)
+		rateLimitDroppedMsgMeter.Mark(1)
+		return false
+	}
+	l.receive(code, size, now)
+	return true
+}
+
+// Wait waits until the message is within the limits and takes the tokens of the message.
+// Priority messages never wait, even behind the other waiting messages.
+// It returns false if the peer is disconnected while waiting.
+func (l *RateLimiter) Wait(code uint64, size uint32, priority bool) bool {
+	if l == nil {
+		return true
+	}
+	if priority {
+		l.lock.Lock()
+		l.receive(code, size, time.Now())
+		l.lock.Unlock()
+		return true
+	}
+	l.waitLock.Lock()
+	defer l.waitLock.Unlock()
+
+	for delayed := false; ; delayed = true {
+		l.lock.Lock()
+		now := time.Now()
+		delay := l.delay(code, size, priority, now)
+		if delay == 0 {
+			l.receive(code, size, now)
+			l.lock.Unlock()
+			return true
+		}
+		if !delayed {
+			l.usage.DelayedMsgs++
+			l.delayMeter.Mark(1)
+			rateLimitDelayedMsgMeter.Mark(1)
+		}
+		l.lock.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-l.closed:
+			timer.Stop()
+			return false
+		}
+	}
+}
+
+// Usage returns the inbound traffic of the peer since it is connected.
+func (l *RateLimiter) Usage() *RateLimitUsage {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	usage := l.usage
+	usage.InboundBytesRate = l.bytesMeter.Rate1()
+	usage.MsgCodes = make(map[uint64]uint64, len(l.usage.MsgCodes))
+	for code, count := range l.usage.MsgCodes {
+		usage.MsgCodes[code] = count
+	}
+	return &usage
+}
+
+// stop unregisters the metrics of the peer.
+func (l *RateLimiter) stop() {
+	if l == nil {
+		return
+	}
+	for _, name := range []string{"InboundBytes", "InboundMsgs", "DelayedMsgs", "DroppedMsgs"} {
+		metrics.DefaultRegistry.Unregister(l.metricPrefix + name)
+	}
+}
+
+// connTypeName returns the name of the node type of the connection type.
+func connTypeName(connType ConnType) string {
+	switch connType {
+	case CONSENSUSNODE:
+		return "cn"
+	case ENDPOINTNODE:
+		return "en"
+	case PROXYNODE:
+		return "pn"
+	case BOOTNODE:
+		return "bn"
+	default:
+		return "unknown"
+	}
+}
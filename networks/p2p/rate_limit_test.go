@@ -0,0 +1,94 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_MsgRate(t *testing.T) {
+	closed := make(chan struct{})
+	l := newRateLimiter(RateLimit{MsgRate: 3, MsgCodeRates: map[uint64]uint64{5: 1}}, randomID(), ENDPOINTNODE, closed)
+	defer l.stop()
+
+	assert.True(t, l.Allow(5, 10, false))
+	assert.False(t, l.Allow(5, 10, false)) // over the limit of the message code
+	assert.True(t, l.Allow(1, 10, false))
+	assert.True(t, l.Allow(1, 10, false))
+	assert.False(t, l.Allow(1, 10, false)) // over the message rate
+	assert.True(t, l.Allow(1, 10, true))   // priority messages are not limited
+
+	// The priority message took a token, so it takes two tokens to receive a message.
+	start := time.Now()
+	assert.True(t, l.Wait(1, 10, false))
+	assert.True(t, time.Since(start) >= 500*time.Millisecond)
+
+	usage := l.Usage()
+	assert.Equal(t, uint64(5), usage.InboundMsgs)
+	assert.Equal(t, uint64(50), usage.InboundBytes)
+	assert.Equal(t, uint64(2), usage.DroppedMsgs)
+	assert.Equal(t, uint64(1), usage.DelayedMsgs)
+	assert.Equal(t, map[uint64]uint64{1: 4, 5: 1}, usage.MsgCodes)
+
+	// A priority message does not wait behind a waiting message.
+	done := make(chan bool)
+	go func() { done <- l.Wait(1, 10, false) }()
+	time.Sleep(10 * time.Millisecond)
+	start = time.Now()
+	assert.True(t, l.Wait(1, 10, true))
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+	assert.True(t, <-done)
+
+	// Waiting is stopped when the peer is disconnected.
+	l = newRateLimiter(RateLimit{MsgRate: 1}, randomID(), ENDPOINTNODE, closed)
+	defer l.stop()
+	assert.True(t, l.Allow(1, 10, false))
+	close(closed)
+	assert.False(t, l.Wait(1, 10, false))
+}
+
+func TestRateLimiter_Bandwidth(t *testing.T) {
+	l := newRateLimiter(RateLimit{Bandwidth: 100}, randomID(), CONSENSUSNODE, nil)
+	defer l.stop()
+
+	// A message larger than the bucket is received when the bucket is full.
+	assert.True(t, l.Allow(1, 1000, false))
+	assert.False(t, l.Allow(1, 1, false))
+
+	// A nil limiter limits nothing.
+	var nilLimiter *RateLimiter
+	assert.True(t, nilLimiter.Allow(1, 1000, false))
+	assert.True(t, nilLimiter.Wait(1, 1000, false))
+	assert.Nil(t, nilLimiter.Usage())
+}
+
+func TestParseRateLimit(t *testing.T) {
+	limit, err := ParseRateLimit("bandwidth=1048576, msgrate=1000,msgrate.0x10=100")
+	if assert.NoError(t, err) {
+		assert.Equal(t, RateLimit{Bandwidth: 1048576, MsgRate: 1000, MsgCodeRates: map[uint64]uint64{16: 100}}, limit)
+	}
+	limit, err = ParseRateLimit("")
+	if assert.NoError(t, err) {
+		assert.Equal(t, RateLimit{}, limit)
+	}
+	for _, invalid := range []string{"bandwidth", "bandwidth=-1", "msgrate.tx=1", "latency=1"} {
+		_, err := ParseRateLimit(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
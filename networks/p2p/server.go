@@ -173,6 +173,9 @@ type Config struct {
 
 	// NetworkID to use for selecting peers to connect to
 	NetworkID uint64
+
+	// RateLimitConfig is a configuration of the inbound rate limits of the peers.
+	RateLimitConfig RateLimitConfig
 }
 
 // NewServer returns a new Server interface.
@@ -281,6 +284,17 @@ type Server interface {
 	// Peers returns all connected peers.
 	Peers() []*Peer
 
+	// BannedPeers returns the bans of node IDs and IP addresses which have not expired.
+	BannedPeers() []*discover.Ban
+
+	// BanPeer bans the node ID or the IP address of the ban until its expiry time,
+	// and disconnects the connected peers matching it.
+	BanPeer(ban *discover.Ban) error
+
+	// UnbanPeer lifts the ban of the node ID or the IP address of the ban.
+	// It returns false if there is no such ban.
+	UnbanPeer(ban *discover.Ban) (bool, error)
+
 	// NodeDialer is used to connect to nodes in the network, typically by using
 	// an underlying net.Dialer but also using net.Pipe in tests.
 	NodeDialer
@@ -383,6 +397,9 @@ func (srv *MultiChannelServer) Start() (err error) {
 		}
 		srv.ntab = ntab
 	}
+	if err := srv.setupScorer(); err != nil {
+		return err
+	}
 
 	dialer := newDialState(srv.StaticNodes, srv.BootstrapNodes, srv.ntab, srv.maxDialedConns(), srv.NetRestrict, srv.PrivateKey, srv.getTypeStatics())
 
@@ -740,6 +757,8 @@ running:
 					if srv.EnableMsgEvents {
 						p.events = &srv.peerFeed
 					}
+					p.scorer = srv.scorer
+					p.limiter = newRateLimiter(srv.RateLimitConfig.limitOf(c.conntype), c.id, c.conntype, p.closed)
 					name := truncateName(c.name)
 					srv.logger.Debug("Adding p2p peer", "name", name, "addr", c.fd.RemoteAddr(), "peers", len(peers)+1)
 					go srv.runPeer(p)
@@ -779,6 +798,9 @@ running:
 	if srv.ntab != nil {
 		srv.ntab.Close()
 	}
+	if srv.banDB != nil {
+		srv.banDB.Close()
+	}
 	//if srv.DiscV5 != nil {
 	//	srv.DiscV5.Close()
 	//}
@@ -847,6 +869,8 @@ func (srv *MultiChannelServer) runPeer(p *Peer) {
 		Error: err.Error(),
 	})
 
+	p.limiter.stop()
+
 	// Note: run waits for existing peers to be sent on srv.delpeer
 	// before returning, so this send should not select on srv.quit.
 	srv.delpeer <- peerDrop{p, err, remoteRequested}
@@ -895,6 +919,8 @@ type BaseServer struct {
 	running bool
 
 	ntab         discover.Discovery
+	banDB        *discover.BanDB // stores the bans if the discovery is disabled
+	scorer       *peerScorer     // nil until the server starts
 	listener     net.Listener
 	ourHandshake *protoHandshake
 	lastLookup   time.Time
@@ -1278,6 +1304,9 @@ func (srv *BaseServer) Start() (err error) {
 		}
 		srv.ntab = ntab
 	}
+	if err := srv.setupScorer(); err != nil {
+		return err
+	}
 
 	dialer := newDialState(srv.StaticNodes, srv.BootstrapNodes, srv.ntab, srv.maxDialedConns(), srv.NetRestrict, srv.PrivateKey, srv.getTypeStatics())
 
@@ -1446,6 +1475,8 @@ running:
 					if srv.EnableMsgEvents {
 						p.events = &srv.peerFeed
 					}
+					p.scorer = srv.scorer
+					p.limiter = newRateLimiter(srv.RateLimitConfig.limitOf(c.conntype), c.id, c.conntype, p.closed)
 					name := truncateName(c.name)
 					srv.logger.Debug("Adding p2p peer", "name", name, "addr", c.fd.RemoteAddr(), "peers", len(peers)+1)
 					go srv.runPeer(p)
@@ -1494,6 +1525,9 @@ running:
 	if srv.ntab != nil {
 		srv.ntab.Close()
 	}
+	if srv.banDB != nil {
+		srv.banDB.Close()
+	}
 	//if srv.DiscV5 != nil {
 	//	srv.DiscV5.Close()
 	//}
@@ -1531,11 +1565,43 @@ func (srv *BaseServer) encHandshakeChecks(peers map[discover.NodeID]*Peer, inbou
 		return DiscAlreadyConnected
 	case c.id == srv.Self().ID:
 		return DiscSelf
+	case !c.is(trustedConn) && srv.isBanned(c):
+		// DiscUselessPeer is used since the remote may not know a reason for bans.
+		return DiscUselessPeer
 	default:
 		return nil
 	}
 }
 
+// isBanned returns true if the node ID or the IP address of the connection is banned.
+func (srv *BaseServer) isBanned(c *conn) bool {
+	if srv.scorer == nil {
+		return false
+	}
+	if ban := srv.scorer.banned(c.id, remoteIP(c.fd)); ban != nil {
+		srv.logger.Trace("Rejecting a banned peer", "id", c.id, "addr", c.fd.RemoteAddr(), "reason", ban.Reason)
+		rejectedBannedConnMeter.Mark(1)
+		return true
+	}
+	return false
+}
+
+// setupScorer creates the peer scorer, which stores the bans in the node table, or in
+// the node database opened separately if the discovery is disabled.
+func (srv *BaseServer) setupScorer() error {
+	if !srv.NoDiscovery {
+		srv.scorer = newPeerScorer(srv.ntab)
+		return nil
+	}
+	banDB, err := discover.OpenBanDB(srv.NodeDatabase, discover.PubkeyID(&srv.PrivateKey.PublicKey))
+	if err != nil {
+		return err
+	}
+	srv.banDB = banDB
+	srv.scorer = newPeerScorer(banDB)
+	return nil
+}
+
 func (srv *BaseServer) maxInboundConns() int {
 	return srv.Config.MaxPhysicalConnections - srv.maxDialedConns()
 }
@@ -1766,6 +1832,8 @@ func (srv *BaseServer) runPeer(p *Peer) {
 		Error: err.Error(),
 	})
 
+	p.limiter.stop()
+
 	// Note: run waits for existing peers to be sent on srv.delpeer
 	// before returning, so this send should not select on srv.quit.
 	srv.delpeer <- peerDrop{p, err, remoteRequested}
@@ -1834,6 +1902,38 @@ func (srv *BaseServer) PeersInfo() []*PeerInfo {
 	return infos
 }
 
+// BannedPeers returns the bans of node IDs and IP addresses which have not expired.
+func (srv *BaseServer) BannedPeers() []*discover.Ban {
+	if srv.scorer == nil {
+		return nil
+	}
+	return srv.scorer.list()
+}
+
+// BanPeer bans the node ID or the IP address of the ban until its expiry time,
+// and disconnects the connected peers matching it.
+func (srv *BaseServer) BanPeer(ban *discover.Ban) error {
+	if srv.scorer == nil {
+		return errServerStopped
+	}
+	srv.scorer.ban(ban)
+	for _, p := range srv.Peers() {
+		if (ban.IsIPBan() && ban.IP.Equal(remoteIP(p.rws[ConnDefault].fd))) || (!ban.IsIPBan() && ban.ID == p.ID()) {
+			p.Disconnect(DiscUselessPeer)
+		}
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of the node ID or the IP address of the ban.
+// It returns false if there is no such ban.
+func (srv *BaseServer) UnbanPeer(ban *discover.Ban) (bool, error) {
+	if srv.scorer == nil {
+		return false, errServerStopped
+	}
+	return srv.scorer.unban(ban), nil
+}
+
 // Disconnect tries to disconnect peer.
 func (srv *BaseServer) Disconnect(destID discover.NodeID) {
 	srv.discpeer <- destID
@@ -266,3 +266,7 @@ func (b *CNAPIBackend) IsParallelDBWrite() bool {
 func (b *CNAPIBackend) IsSenderTxHashIndexingEnabled() bool {
 	return b.cn.BlockChain().IsSenderTxHashIndexingEnabled()
 }
+
+func (b *CNAPIBackend) GetAccountKeyHistory(addr common.Address) ([]*blockchain.AccountKeyChange, error) {
+	return b.cn.BlockChain().GetAccountKeyHistory(addr)
+}
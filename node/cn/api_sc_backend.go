@@ -266,3 +266,7 @@ func (b *ServiceChainAPIBackend) IsParallelDBWrite() bool {
 func (b *ServiceChainAPIBackend) IsSenderTxHashIndexingEnabled() bool {
 	return b.sc.BlockChain().IsSenderTxHashIndexingEnabled()
 }
+
+func (b *ServiceChainAPIBackend) GetAccountKeyHistory(addr common.Address) ([]*blockchain.AccountKeyChange, error) {
+	return b.sc.BlockChain().GetAccountKeyHistory(addr)
+}
@@ -708,6 +708,37 @@ func (api *PrivateDebugAPI) TraceTransaction(ctx context.Context, hash common.Ha
 	return api.traceTx(ctx, msg, vmctx, statedb, config)
 }
 
+// ProfileComputationCost re-executes the transaction and returns the number of executions,
+// the gas and the computation cost of each opcode and precompiled contract used by it.
+func (api *PrivateDebugAPI) ProfileComputationCost(ctx context.Context, hash common.Hash, config *TraceConfig) (*vm.ComputationCostResult, error) {
+	tx, blockHash, _, index := api.cn.ChainDB().ReadTxAndLookupInfo(hash)
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %#x not found", hash)
+	}
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+	msg, vmctx, statedb, err := api.computeTxEnv(blockHash, int(index), reexec)
+	if err != nil {
+		return nil, err
+	}
+	// Execute the transaction as in the block processing, which enables the opcode computation cost limit
+	vmConfig := *api.cn.blockchain.GetVMConfig()
+	vmConfig.UseOpcodeComputationCost = true
+
+	profiler := vm.NewComputationCostProfiler(false)
+	vmConfig.Debug = true
+	vmConfig.Tracer = profiler
+	vmenv := vm.NewEVM(vmctx, statedb, api.config, &vmConfig)
+
+	_, gas, kerr := blockchain.ApplyMessage(vmenv, msg)
+	if kerr.ErrTxInvalid != nil {
+		return nil, fmt.Errorf("profiling failed: %v", kerr.ErrTxInvalid)
+	}
+	return profiler.Result(gas, kerr.Status != types.ReceiptStatusSuccessful), nil
+}
+
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
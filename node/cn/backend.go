@@ -162,8 +162,10 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	}
 	chainDB := CreateDB(ctx, config, "chaindata")
 
-	chainConfig, genesisHash, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, config.IsPrivate)
-	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
+	chainConfig, _, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, config.IsPrivate)
+	// A *params.ConfigCompatError is not ignored either. The chain is not rewound to apply
+	// the new config, because the blocks finalized by the consensus should not be replaced.
+	if genesisErr != nil {
 		return nil, genesisErr
 	}
 
@@ -213,7 +215,8 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 		vmConfig    = vm.Config{EnablePreimageRecording: config.EnablePreimageRecording}
 		cacheConfig = &blockchain.CacheConfig{StateDBCaching: config.StateDBCaching,
 			ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize, BlockInterval: config.TrieBlockInterval,
-			TxPoolStateCache: config.TxPoolStateCache, TrieCacheLimit: config.TrieCacheLimit, SenderTxHashIndexing: config.SenderTxHashIndexing}
+			TxPoolStateCache: config.TxPoolStateCache, TrieCacheLimit: config.TrieCacheLimit, SenderTxHashIndexing: config.SenderTxHashIndexing,
+			AccountKeyHistoryIndexing: config.AccountKeyHistoryIndexing, ParallelTxExecution: config.ParallelTxExecution}
 	)
 	var err error
 
@@ -237,18 +240,11 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 		go senderTxHashIndexer(chainDB, ch, chainEventSubscription)
 	}
 
-	// Rewind the chain in case of an incompatible config upgrade.
-	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
-		logger.Error("Rewinding chain to upgrade configuration", "err", compat)
-		cn.blockchain.SetHead(compat.RewindTo)
-		chainDB.WriteChainConfig(genesisHash, chainConfig)
-	}
 	cn.bloomIndexer.Start(cn.blockchain)
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
-	// TODO-Klaytn-ServiceChain: add account creation prevention in the txPool if TxTypeAccountCreation is supported.
 	config.TxPool.NoAccountCreation = config.NoAccountCreation
 	cn.txPool = blockchain.NewTxPool(config.TxPool, cn.chainConfig, bc)
 	governance.SetTxPool(cn.txPool)
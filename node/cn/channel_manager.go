@@ -18,6 +18,7 @@ package cn
 
 import (
 	"fmt"
+	"github.com/klaytn/klaytn/consensus/istanbul/backend"
 	"github.com/klaytn/klaytn/networks/p2p"
 )
 
@@ -69,6 +70,18 @@ func NewChannelManager(channelSize int) *ChannelManager {
 	return channelMgr
 }
 
+// isPriorityMsg returns true if the message is a consensus message or a message
+// propagating a new block. Priority messages are never throttled by the rate limits
+// of the peer, while the requests and the responses of the synchronization are.
+func isPriorityMsg(msgCode uint64) bool {
+	switch msgCode {
+	case backend.IstanbulMsg, NewBlockHashesMsg, NewBlockMsg:
+		return true
+	default:
+		return false
+	}
+}
+
 // RegisterChannelWithIndex registers the channel corresponding to network and channel ID.
 func (cm *ChannelManager) RegisterChannelWithIndex(idx int, channelId uint, channel chan p2p.Msg) {
 	cm.msgChannels[idx][channelId] = channel
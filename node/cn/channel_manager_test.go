@@ -96,3 +96,13 @@ func testChannelManager(t *testing.T, chSize int) {
 		assert.Error(t, err)
 	}
 }
+
+// TestIsPriorityMsg tests that only the consensus messages and the messages propagating
+// a new block are the priority messages.
+func TestIsPriorityMsg(t *testing.T) {
+	priorityMsgs := map[uint64]bool{backend.IstanbulMsg: true, NewBlockHashesMsg: true, NewBlockMsg: true}
+	for code := uint64(StatusMsg); code < MsgCodeEnd; code++ {
+		assert.Equal(t, priorityMsgs[code], isPriorityMsg(code), "msg code %d", code)
+	}
+	assert.True(t, isPriorityMsg(backend.IstanbulMsg))
+}
@@ -89,20 +89,21 @@ type Config struct {
 	//LightPeers int `toml:",omitempty"` // Maximum number of LES client peers
 
 	// Database options
-	SkipBcVersionCheck     bool `toml:"-"`
-	PartitionedDB          bool
-	NumStateTriePartitions uint
-	LevelDBCompression     database.LevelDBCompressionType
-	LevelDBBufferPool      bool
-	LevelDBCacheSize       int
-	TrieCacheSize          int
-	TrieTimeout            time.Duration
-	TrieBlockInterval      uint
-	SenderTxHashIndexing   bool
-	ParallelDBWrite        bool
-	StateDBCaching         bool
-	TxPoolStateCache       bool
-	TrieCacheLimit         int
+	SkipBcVersionCheck        bool `toml:"-"`
+	PartitionedDB             bool
+	NumStateTriePartitions    uint
+	LevelDBCompression        database.LevelDBCompressionType
+	LevelDBBufferPool         bool
+	LevelDBCacheSize          int
+	TrieCacheSize             int
+	TrieTimeout               time.Duration
+	TrieBlockInterval         uint
+	SenderTxHashIndexing      bool
+	AccountKeyHistoryIndexing bool
+	ParallelDBWrite           bool
+	StateDBCaching            bool
+	TxPoolStateCache          bool
+	TrieCacheLimit            int
 
 	// Mining-related options
 	ServiceChainSigner common.Address `toml:",omitempty"`
@@ -120,6 +121,8 @@ type Config struct {
 
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool
+	// Enables executing the transactions of a block in parallel
+	ParallelTxExecution bool
 	// Istanbul options
 	Istanbul istanbul.Config
 
@@ -121,6 +121,10 @@ func (mock *backendMock) IsSenderTxHashIndexingEnabled() bool {
 	return false
 }
 
+func (mock *backendMock) GetAccountKeyHistory(addr common.Address) ([]*blockchain.AccountKeyChange, error) {
+	return nil, nil
+}
+
 // TxPool API
 func (mock *backendMock) SendTx(ctx context.Context, signedTx *types.Transaction) error {
 	return nil
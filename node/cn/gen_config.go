@@ -20,41 +20,43 @@ var _ = (*configMarshaling)(nil)
 // MarshalTOML marshals as TOML.
 func (c Config) MarshalTOML() (interface{}, error) {
 	type Config struct {
-		Genesis                 *blockchain.Genesis `toml:",omitempty"`
-		NetworkId               uint64
-		SyncMode                downloader.SyncMode
-		NoPruning               bool
-		ParentOperatorAddr      *common.Address `toml:",omitempty"`
-		AnchoringPeriod         uint64
-		SentChainTxsLimit       uint64
-		SkipBcVersionCheck      bool `toml:"-"`
-		PartitionedDB           bool
-		NumStateTriePartitions  uint
-		LevelDBCompression      database.LevelDBCompressionType
-		LevelDBBufferPool       bool
-		LevelDBCacheSize        int
-		TrieCacheSize           int
-		TrieTimeout             time.Duration
-		TrieBlockInterval       uint
-		SenderTxHashIndexing    bool
-		ParallelDBWrite         bool
-		StateDBCaching          bool
-		TxPoolStateCache        bool
-		TrieCacheLimit          int
-		ServiceChainSigner      common.Address `toml:",omitempty"`
-		ExtraData               hexutil.Bytes  `toml:",omitempty"`
-		GasPrice                *big.Int
-		Rewardbase              common.Address `toml:",omitempty"`
-		TxPool                  blockchain.TxPoolConfig
-		GPO                     gasprice.Config
-		EnablePreimageRecording bool
-		Istanbul                istanbul.Config
-		DocRoot                 string `toml:"-"`
-		WsEndpoint              string `toml:",omitempty"`
-		TxResendInterval        uint64
-		TxResendCount           int
-		TxResendUseLegacy       bool
-		NoAccountCreation       bool
+		Genesis                   *blockchain.Genesis `toml:",omitempty"`
+		NetworkId                 uint64
+		SyncMode                  downloader.SyncMode
+		NoPruning                 bool
+		ParentOperatorAddr        *common.Address `toml:",omitempty"`
+		AnchoringPeriod           uint64
+		SentChainTxsLimit         uint64
+		SkipBcVersionCheck        bool `toml:"-"`
+		PartitionedDB             bool
+		NumStateTriePartitions    uint
+		LevelDBCompression        database.LevelDBCompressionType
+		LevelDBBufferPool         bool
+		LevelDBCacheSize          int
+		TrieCacheSize             int
+		TrieTimeout               time.Duration
+		TrieBlockInterval         uint
+		SenderTxHashIndexing      bool
+		AccountKeyHistoryIndexing bool
+		ParallelDBWrite           bool
+		StateDBCaching            bool
+		TxPoolStateCache          bool
+		TrieCacheLimit            int
+		ServiceChainSigner        common.Address `toml:",omitempty"`
+		ExtraData                 hexutil.Bytes  `toml:",omitempty"`
+		GasPrice                  *big.Int
+		Rewardbase                common.Address `toml:",omitempty"`
+		TxPool                    blockchain.TxPoolConfig
+		GPO                       gasprice.Config
+		EnablePreimageRecording   bool
+		ParallelTxExecution       bool
+		Istanbul                  istanbul.Config
+		DocRoot                   string `toml:"-"`
+		WsEndpoint                string `toml:",omitempty"`
+		TxResendInterval          uint64
+		TxResendCount             int
+		TxResendUseLegacy         bool
+		NoAccountCreation         bool
 	}
 	var enc Config
 	enc.Genesis = c.Genesis
@@ -74,6 +76,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieTimeout = c.TrieTimeout
 	enc.TrieBlockInterval = c.TrieBlockInterval
 	enc.SenderTxHashIndexing = c.SenderTxHashIndexing
+	enc.AccountKeyHistoryIndexing = c.AccountKeyHistoryIndexing
 	enc.ParallelDBWrite = c.ParallelDBWrite
 	enc.StateDBCaching = c.StateDBCaching
 	enc.TxPoolStateCache = c.TxPoolStateCache
@@ -85,6 +88,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TxPool = c.TxPool
 	enc.GPO = c.GPO
 	enc.EnablePreimageRecording = c.EnablePreimageRecording
+	enc.ParallelTxExecution = c.ParallelTxExecution
 	enc.Istanbul = c.Istanbul
 	enc.DocRoot = c.DocRoot
 	enc.WsEndpoint = c.WsEndpoint
@@ -98,41 +102,43 @@ func (c Config) MarshalTOML() (interface{}, error) {
 // UnmarshalTOML unmarshals from TOML.
 func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	type Config struct {
-		Genesis                 *blockchain.Genesis `toml:",omitempty"`
-		NetworkId               *uint64
-		SyncMode                *downloader.SyncMode
-		NoPruning               *bool
-		ParentOperatorAddr      *common.Address `toml:",omitempty"`
-		AnchoringPeriod         *uint64
-		SentChainTxsLimit       *uint64
-		SkipBcVersionCheck      *bool `toml:"-"`
-		PartitionedDB           *bool
-		NumStateTriePartitions  *uint
-		LevelDBCompression      *database.LevelDBCompressionType
-		LevelDBBufferPool       *bool
-		LevelDBCacheSize        *int
-		TrieCacheSize           *int
-		TrieTimeout             *time.Duration
-		TrieBlockInterval       *uint
-		SenderTxHashIndexing    *bool
-		ParallelDBWrite         *bool
-		StateDBCaching          *bool
-		TxPoolStateCache        *bool
-		TrieCacheLimit          *int
-		ServiceChainSigner      *common.Address `toml:",omitempty"`
-		ExtraData               *hexutil.Bytes  `toml:",omitempty"`
-		GasPrice                *big.Int
-		Rewardbase              *common.Address `toml:",omitempty"`
-		TxPool                  *blockchain.TxPoolConfig
-		GPO                     *gasprice.Config
-		EnablePreimageRecording *bool
-		Istanbul                *istanbul.Config
-		DocRoot                 *string `toml:"-"`
-		WsEndpoint              *string `toml:",omitempty"`
-		TxResendInterval        *uint64
-		TxResendCount           *int
-		TxResendUseLegacy       *bool
-		NoAccountCreation       *bool
+		Genesis                   *blockchain.Genesis `toml:",omitempty"`
+		NetworkId                 *uint64
+		SyncMode                  *downloader.SyncMode
+		NoPruning                 *bool
+		ParentOperatorAddr        *common.Address `toml:",omitempty"`
+		AnchoringPeriod           *uint64
+		SentChainTxsLimit         *uint64
+		SkipBcVersionCheck        *bool `toml:"-"`
+		PartitionedDB             *bool
+		NumStateTriePartitions    *uint
+		LevelDBCompression        *database.LevelDBCompressionType
+		LevelDBBufferPool         *bool
+		LevelDBCacheSize          *int
+		TrieCacheSize             *int
+		TrieTimeout               *time.Duration
+		TrieBlockInterval         *uint
+		SenderTxHashIndexing      *bool
+		AccountKeyHistoryIndexing *bool
+		ParallelDBWrite           *bool
+		StateDBCaching            *bool
+		TxPoolStateCache          *bool
+		TrieCacheLimit            *int
+		ServiceChainSigner        *common.Address `toml:",omitempty"`
+		ExtraData                 *hexutil.Bytes  `toml:",omitempty"`
+		GasPrice                  *big.Int
+		Rewardbase                *common.Address `toml:",omitempty"`
+		TxPool                    *blockchain.TxPoolConfig
+		GPO                       *gasprice.Config
+		EnablePreimageRecording   *bool
+		ParallelTxExecution       *bool
+		Istanbul                  *istanbul.Config
+		DocRoot                   *string `toml:"-"`
+		WsEndpoint                *string `toml:",omitempty"`
+		TxResendInterval          *uint64
+		TxResendCount             *int
+		TxResendUseLegacy         *bool
+		NoAccountCreation         *bool
 	}
 	var dec Config
 	if err := unmarshal(&dec); err != nil {
@@ -189,6 +195,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SenderTxHashIndexing != nil {
 		c.SenderTxHashIndexing = *dec.SenderTxHashIndexing
 	}
+	if dec.AccountKeyHistoryIndexing != nil {
+		c.AccountKeyHistoryIndexing = *dec.AccountKeyHistoryIndexing
+	}
 	if dec.ParallelDBWrite != nil {
 		c.ParallelDBWrite = *dec.ParallelDBWrite
 	}
@@ -222,6 +231,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.EnablePreimageRecording != nil {
 		c.EnablePreimageRecording = *dec.EnablePreimageRecording
 	}
+	if dec.ParallelTxExecution != nil {
+		c.ParallelTxExecution = *dec.ParallelTxExecution
+	}
 	if dec.Istanbul != nil {
 		c.Istanbul = *dec.Istanbul
 	}
@@ -233,7 +233,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 		return nil, errIncompatibleConfig
 	}
 	// Construct the different synchronisation mechanisms
-	manager.downloader = downloader.New(mode, chainDB, manager.eventMux, blockchain, nil, manager.removePeer)
+	manager.downloader = downloader.New(mode, chainDB, manager.eventMux, blockchain, nil, manager.penalizePeer)
 
 	validator := func(header *types.Header) error {
 		return engine.VerifyHeader(blockchain, header, true)
@@ -250,7 +250,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 		atomic.StoreUint32(&manager.acceptTxs, 1) // Mark initial sync done on any fetcher import
 		return manager.blockchain.InsertChain(blocks)
 	}
-	manager.fetcher = fetcher.New(blockchain.GetBlockByHash, validator, manager.BroadcastBlock, manager.BroadcastBlockHash, heighter, inserter, manager.removePeer)
+	manager.fetcher = fetcher.New(blockchain.GetBlockByHash, validator, manager.BroadcastBlock, manager.BroadcastBlockHash, heighter, inserter, manager.penalizePeer)
 
 	if manager.useTxResend() {
 		go manager.txResendLoop(cnconfig.TxResendInterval, cnconfig.TxResendCount)
@@ -294,6 +294,27 @@ func (pm *ProtocolManager) removePeer(id string) {
 	}
 }
 
+// penalizePeer adds the penalty to the score of the peer and removes the peer.
+// The peer is banned if its score reaches the threshold.
+func (pm *ProtocolManager) penalizePeer(id string, penalty p2p.Penalty) {
+	if peer := pm.peers.Peer(id); peer != nil {
+		peer.GetP2PPeer().Penalize(penalty, "dropped by the downloader or the fetcher")
+	}
+	pm.removePeer(id)
+}
+
+// throttleMsg applies the rate limits of the peer to an inbound message. Transactions
+// over the limits are dropped since other peers propagate them as well. The other
+// messages wait for the limits, since a dropped response would stall the downloader.
+// It returns false if the message is dropped or the peer is disconnected while waiting.
+func throttleMsg(p Peer, msg p2p.Msg) bool {
+	limiter := p.GetP2PPeer().RateLimiter()
+	if msg.Code == TxMsg {
+		return limiter.Allow(msg.Code, msg.Size, isPriorityMsg(msg.Code))
+	}
+	return limiter.Wait(msg.Code, msg.Size, isPriorityMsg(msg.Code))
+}
+
 // getChainID returns the current chain id.
 func (pm *ProtocolManager) getChainID() *big.Int {
 	return pm.blockchain.Config().ChainID
@@ -428,8 +449,13 @@ func (pm *ProtocolManager) handle(p Peer) error {
 		if msg.Size > ProtocolMaxMsgSize {
 			err := errResp(ErrMsgTooLarge, "%v > %v", msg.Size, ProtocolMaxMsgSize)
 			p.GetP2PPeer().Log().Warn("ProtocolManager over max msg size", "err", err)
+			p.GetP2PPeer().Penalize(p2p.PenaltyProtocolError, err.Error())
 			return err
 		}
+		if !throttleMsg(p, msg) {
+			msg.Discard()
+			continue
+		}
 
 		select {
 		case err := <-errChannel:
@@ -449,6 +475,7 @@ func (pm *ProtocolManager) processMsg(msgCh <-chan p2p.Msg, p Peer, addr common.
 	for msg := range msgCh {
 		if err := pm.handleMsg(p, addr, msg); err != nil {
 			p.GetP2PPeer().Log().Error("ProtocolManager failed to handle message", "msg", msg, "err", err)
+			p.GetP2PPeer().Penalize(p2p.PenaltyProtocolError, err.Error())
 			errCh <- err
 			return
 		}
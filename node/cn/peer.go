@@ -954,6 +954,7 @@ func (p *multiChannelPeer) ReadMsg(rw p2p.MsgReadWriter, connectionOrder int, er
 		msgCh, err := p.chMgr.GetChannelWithMsgCode(connectionOrder, msg.Code)
 		if err != nil {
 			p.GetP2PPeer().Log().Warn("ProtocolManager failed to get msg channel", "err", err)
+			p.GetP2PPeer().Penalize(p2p.PenaltyProtocolError, err.Error())
 			errCh <- err
 			return
 		}
@@ -961,9 +962,14 @@ func (p *multiChannelPeer) ReadMsg(rw p2p.MsgReadWriter, connectionOrder int, er
 		if msg.Size > ProtocolMaxMsgSize {
 			err := errResp(ErrMsgTooLarge, "%v > %v", msg.Size, ProtocolMaxMsgSize)
 			p.GetP2PPeer().Log().Warn("ProtocolManager over max msg size", "err", err)
+			p.GetP2PPeer().Penalize(p2p.PenaltyProtocolError, err.Error())
 			errCh <- err
 			return
 		}
+		if !throttleMsg(p, msg) {
+			msg.Discard()
+			continue
+		}
 
 		select {
 		case msgCh <- msg:
@@ -93,8 +93,10 @@ func NewServiceChain(ctx *node.ServiceContext, config *Config) (*ServiceChain, e
 	}
 	chainDB := CreateDB(ctx, config, "chaindata")
 
-	chainConfig, genesisHash, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, false)
-	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
+	chainConfig, _, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, false)
+	// A *params.ConfigCompatError is not ignored either. The chain is not rewound to apply
+	// the new config, because the blocks finalized by the consensus should not be replaced.
+	if genesisErr != nil {
 		return nil, genesisErr
 	}
 
@@ -128,25 +130,18 @@ func NewServiceChain(ctx *node.ServiceContext, config *Config) (*ServiceChain, e
 	}
 	var (
 		vmConfig    = vm.Config{EnablePreimageRecording: config.EnablePreimageRecording}
-		cacheConfig = &blockchain.CacheConfig{StateDBCaching: config.StateDBCaching, ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize, BlockInterval: config.TrieBlockInterval}
+		cacheConfig = &blockchain.CacheConfig{StateDBCaching: config.StateDBCaching, ArchiveMode: config.NoPruning, CacheSize: config.TrieCacheSize, BlockInterval: config.TrieBlockInterval, AccountKeyHistoryIndexing: config.AccountKeyHistoryIndexing, ParallelTxExecution: config.ParallelTxExecution}
 	)
 	bc, err := blockchain.NewBlockChain(chainDB, cacheConfig, cn.chainConfig, cn.engine, vmConfig)
 	if err != nil {
 		return nil, err
 	}
 	cn.blockchain = bc
-	// Rewind the chain in case of an incompatible config upgrade.
-	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
-		logger.Error("Rewinding chain to upgrade configuration", "err", compat)
-		cn.blockchain.SetHead(compat.RewindTo)
-		chainDB.WriteChainConfig(genesisHash, chainConfig)
-	}
 	cn.bloomIndexer.Start(cn.blockchain)
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
-	// TODO-Klaytn-ServiceChain: add account creation prevention in the TxPool if TxTypeAccountCreation is supported.
 	config.TxPool.NoAccountCreation = config.NoAccountCreation
 	cn.txPool = blockchain.NewTxPool(config.TxPool, cn.chainConfig, bc)
 
@@ -60,6 +60,7 @@ func runTrace(tracer *Tracer) (json.RawMessage, error) {
 	contract := vm.NewContract(account{}, account{}, big.NewInt(0), 10000)
 	contract.Code = []byte{byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x1, 0x0}
 
+	tracer.CaptureStart(env, contract.Caller(), contract.Address(), false, []byte{}, contract.Gas, contract.Value())
 	_, err := env.Interpreter().Run(contract, []byte{})
 	if err != nil {
 		return nil, err
@@ -140,6 +141,7 @@ func TestHaltBetweenSteps(t *testing.T) {
 	env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(1)}, &dummyStatedb{}, params.TestChainConfig, &vm.Config{Debug: true, Tracer: tracer})
 	contract := vm.NewContract(&account{}, &account{}, big.NewInt(0), 0)
 
+	tracer.CaptureStart(env, contract.Caller(), contract.Address(), false, []byte{}, contract.Gas, contract.Value())
 	tracer.CaptureState(env, 0, 0, 0, 0, nil, nil, contract, 0, nil)
 	timeout := errors.New("stahp")
 	tracer.Stop(timeout)
@@ -149,3 +151,37 @@ func TestHaltBetweenSteps(t *testing.T) {
 		t.Errorf("Expected timeout error, got %v", err)
 	}
 }
+
+// TestIsPrecompiled checks if isPrecompiled selects the precompiled contracts by the hard forks of the traced block.
+func TestIsPrecompiled(t *testing.T) {
+	config := &params.ChainConfig{ChainID: big.NewInt(1), IstanbulCompatibleBlock: big.NewInt(10)}
+	blake2F := "toAddress('0x000000000000000000000000000000000000000c')"
+
+	for _, test := range []struct {
+		blockNumber int64
+		want        string
+	}{
+		{9, "false"},
+		{10, "true"},
+	} {
+		tracer, err := New("{precompiled: false, step: function() { this.precompiled = isPrecompiled(" + blake2F + "); }, fault: function() {}, result: function() { return this.precompiled; }}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(test.blockNumber)}, &dummyStatedb{}, config, &vm.Config{Debug: true, Tracer: tracer})
+		contract := vm.NewContract(account{}, account{}, big.NewInt(0), 10000)
+		contract.Code = []byte{byte(vm.PUSH1), 0x1, 0x0}
+
+		tracer.CaptureStart(env, contract.Caller(), contract.Address(), false, []byte{}, contract.Gas, contract.Value())
+		if _, err := env.Interpreter().Run(contract, []byte{}); err != nil {
+			t.Fatal(err)
+		}
+		ret, err := tracer.GetResult()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(ret) != test.want {
+			t.Errorf("block %d: expected isPrecompiled to return %s, got %s", test.blockNumber, test.want, string(ret))
+		}
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/external"
+	"github.com/klaytn/klaytn/accounts/hdwallet"
 	"github.com/klaytn/klaytn/accounts/keystore"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
@@ -87,6 +89,10 @@ type Config struct {
 	// scrypt KDF at the expense of security.
 	UseLightweightKDF bool `toml:",omitempty"`
 
+	// ExternalSigner is the IPC path or the HTTP URL of an external signer. If it is set,
+	// the accounts of the external signer are managed with the ones of the keystore.
+	ExternalSigner string `toml:",omitempty"`
+
 	// IPCPath is the requested location to place the IPC endpoint. If the path is
 	// a simple file name, it is placed inside the data directory (or on the root
 	// pipe path on Windows), whereas if it's a resolvable path name (absolute or
@@ -442,9 +448,21 @@ func makeAccountManager(conf *Config) (*accounts.Manager, string, error) {
 	if err := os.MkdirAll(keydir, 0700); err != nil {
 		return nil, "", err
 	}
+	hub, err := hdwallet.NewHub(filepath.Join(keydir, hdwallet.DirName), scryptN, scryptP)
+	if err != nil {
+		return nil, "", err
+	}
 	// Assemble the account manager and supported backends
 	backends := []accounts.Backend{
 		keystore.NewKeyStore(keydir, scryptN, scryptP),
+		hub,
+	}
+	if conf.ExternalSigner != "" {
+		extBackend, err := external.NewExternalBackend(conf.ExternalSigner)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to connect to the external signer: %v", err)
+		}
+		backends = append(backends, extBackend)
 	}
 	return accounts.NewManager(backends...), ephemeral, nil
 }
@@ -0,0 +1,57 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feepayer
+
+import (
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+)
+
+// PublicFeePayerAPI provides an API to have the fees of the transactions paid by the fee payer service.
+type PublicFeePayerAPI struct {
+	fp *FeePayer
+}
+
+// NewPublicFeePayerAPI creates a new fee payer API.
+func NewPublicFeePayerAPI(fp *FeePayer) *PublicFeePayerAPI {
+	return &PublicFeePayerAPI{fp}
+}
+
+// Account returns the address of the fee payer account of the service.
+func (api *PublicFeePayerAPI) Account() common.Address {
+	return api.fp.config.FeePayer
+}
+
+// SignAndSend signs the RLP-encoded fee-delegated transaction signed by the sender as a fee payer,
+// and submits it to the transaction pool. It returns the hash of the transaction.
+func (api *PublicFeePayerAPI) SignAndSend(encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx, err := types.DecodeUnsignedTx(encodedTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signed, err := api.fp.SignAndSend(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return signed.Hash(), nil
+}
+
+// GetUsage returns the usage of the service by the sender during the current quota period.
+func (api *PublicFeePayerAPI) GetUsage(sender common.Address) *Usage {
+	return api.fp.Usage(sender)
+}
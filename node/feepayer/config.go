@@ -0,0 +1,35 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feepayer
+
+import "github.com/klaytn/klaytn/common"
+
+// FeePayerConfig is the configuration of the fee payer service.
+type FeePayerConfig struct {
+	EnabledFeePayer bool
+
+	// FeePayer is the account paying the fees. It should be unlocked in the node,
+	// and its RoleFeePayer keys are used to sign the transactions.
+	FeePayer common.Address `toml:",omitempty"`
+
+	// PolicyFile is the JSON file of the policy deciding which transactions are paid.
+	PolicyFile string `toml:",omitempty"`
+}
+
+var DefaultConfig = &FeePayerConfig{
+	EnabledFeePayer: false,
+}
@@ -0,0 +1,220 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feepayer
+
+import (
+	"errors"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"math/big"
+	"sync"
+	"time"
+)
+
+var logger = log.NewModuleLogger(log.NodeFeePayer)
+
+var (
+	ErrNoFeePayer       = errors.New("the fee payer account is not given")
+	ErrNoPolicy         = errors.New("the policy file is not given")
+	ErrNotFeeDelegated  = errors.New("the transaction is not a fee-delegated transaction")
+	ErrFeePayerMismatch = errors.New("the fee payer of the transaction is not the service account")
+	ErrServiceNotReady  = errors.New("the fee payer service is not ready")
+)
+
+// txPool is the subset of blockchain.TxPool used by the fee payer service.
+type txPool interface {
+	AddLocal(tx *types.Transaction) error
+}
+
+// Usage is the usage of the fee payer service by a sender during a quota period.
+// Fee is the sum of the maximum fees of the transactions, since the fees actually
+// paid are not known until the transactions are executed.
+type Usage struct {
+	Txs uint64   `json:"txs"`
+	Fee *big.Int `json:"fee"`
+}
+
+// FeePayer is a service which signs the fee-delegated transactions satisfying the policy
+// as a fee payer and submits them to the transaction pool.
+type FeePayer struct {
+	config *FeePayerConfig
+	policy *Policy
+
+	accountManager *accounts.Manager
+	db             database.DBManager
+
+	chainID *big.Int
+	txPool  txPool
+
+	// mu serializes the quota checks and the usage updates.
+	mu sync.Mutex
+}
+
+// NewFeePayer creates a fee payer service with the given configuration.
+func NewFeePayer(ctx *node.ServiceContext, config *FeePayerConfig) (*FeePayer, error) {
+	if config.FeePayer == (common.Address{}) {
+		return nil, ErrNoFeePayer
+	}
+	if config.PolicyFile == "" {
+		return nil, ErrNoPolicy
+	}
+	policy, err := LoadPolicy(config.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Initialize the fee payer service", "feePayer", config.FeePayer.String(), "policy", config.PolicyFile)
+
+	// OpenFilesLimit and LevelDBCacheSize are used by minimum value.
+	db := ctx.OpenDatabase(&database.DBConfig{Dir: "feepayerdata", DBType: database.LevelDB})
+	return newFeePayer(config, policy, ctx.AccountManager, db), nil
+}
+
+func newFeePayer(config *FeePayerConfig, policy *Policy, am *accounts.Manager, db database.DBManager) *FeePayer {
+	return &FeePayer{
+		config:         config,
+		policy:         policy,
+		accountManager: am,
+		db:             db,
+	}
+}
+
+func (fp *FeePayer) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+// APIs returns the collection of RPC services the fee payer service offers.
+func (fp *FeePayer) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "feepayer",
+			Version:   "1.0",
+			Service:   NewPublicFeePayerAPI(fp),
+			Public:    true,
+		},
+	}
+}
+
+func (fp *FeePayer) Start(server p2p.Server) error {
+	return nil
+}
+
+func (fp *FeePayer) Stop() error {
+	fp.db.Close()
+	return nil
+}
+
+func (fp *FeePayer) Components() []interface{} {
+	return nil
+}
+
+func (fp *FeePayer) SetComponents(components []interface{}) {
+	for _, component := range components {
+		switch v := component.(type) {
+		case *blockchain.BlockChain:
+			fp.chainID = v.Config().ChainID
+		case *blockchain.TxPool:
+			fp.txPool = v
+		}
+	}
+}
+
+// SignAndSend signs the sender-signed fee-delegated transaction as a fee payer if it satisfies
+// the policy, and submits it to the transaction pool. The usage of the sender is updated only
+// if the transaction is accepted by the pool.
+func (fp *FeePayer) SignAndSend(tx *types.Transaction) (*types.Transaction, error) {
+	if fp.txPool == nil || fp.chainID == nil {
+		return nil, ErrServiceNotReady
+	}
+	if !tx.IsFeeDelegatedTransaction() {
+		return nil, ErrNotFeeDelegated
+	}
+	if feePayer, err := tx.FeePayer(); err != nil || feePayer != fp.config.FeePayer {
+		return nil, ErrFeePayerMismatch
+	}
+	sender, err := tx.From()
+	if err != nil {
+		return nil, err
+	}
+	if err := fp.policy.Check(tx); err != nil {
+		return nil, err
+	}
+
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	period := fp.policy.Period(time.Now())
+	usage := fp.readUsage(sender, period)
+	if err := fp.policy.CheckQuota(usage, tx); err != nil {
+		return nil, err
+	}
+
+	account := accounts.Account{Address: fp.config.FeePayer}
+	wallet, err := fp.accountManager.Find(account)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := wallet.SignTxAsFeePayer(account, tx, fp.chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := fp.txPool.AddLocal(signed); err != nil {
+		return nil, err
+	}
+
+	usage.Txs++
+	usage.Fee.Add(usage.Fee, maxFeeOf(signed))
+	fp.writeUsage(sender, period, usage)
+	logger.Debug("Paid the fee of a transaction", "sender", sender, "hash", signed.Hash(), "txs", usage.Txs, "fee", usage.Fee)
+	return signed, nil
+}
+
+// Usage returns the usage of the sender during the current quota period.
+func (fp *FeePayer) Usage(sender common.Address) *Usage {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+	return fp.readUsage(sender, fp.policy.Period(time.Now()))
+}
+
+func (fp *FeePayer) readUsage(sender common.Address, period uint64) *Usage {
+	usage := &Usage{Fee: new(big.Int)}
+	data := fp.db.ReadFeePayerUsage(sender, period)
+	if data == nil {
+		return usage
+	}
+	if err := rlp.DecodeBytes(data, usage); err != nil {
+		logger.Error("Failed to decode the usage", "sender", sender, "period", period, "err", err)
+		return &Usage{Fee: new(big.Int)}
+	}
+	return usage
+}
+
+func (fp *FeePayer) writeUsage(sender common.Address, period uint64, usage *Usage) {
+	data, err := rlp.EncodeToBytes(usage)
+	if err != nil {
+		logger.Error("Failed to encode the usage", "sender", sender, "period", period, "err", err)
+		return
+	}
+	fp.db.WriteFeePayerUsage(sender, period, data)
+}
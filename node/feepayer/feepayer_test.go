@@ -0,0 +1,207 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feepayer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+)
+
+var (
+	testChainID  = big.NewInt(1000)
+	testContract = common.HexToAddress("0x1000")
+	testMethod   = hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb}
+)
+
+type testTxPool struct {
+	txs []*types.Transaction
+	err error
+}
+
+func (pool *testTxPool) AddLocal(tx *types.Transaction) error {
+	if pool.err != nil {
+		return pool.err
+	}
+	pool.txs = append(pool.txs, tx)
+	return nil
+}
+
+// newTestFeePayer creates a fee payer service with an unlocked fee payer account of a new keystore.
+func newTestFeePayer(t *testing.T, policy *Policy, db database.DBManager) (*FeePayer, *testTxPool, func()) {
+	dir, err := ioutil.TempDir("", "klaytn-feepayer-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+	acc, err := ks.NewAccount("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Unlock(acc, "foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := &testTxPool{}
+	fp := newFeePayer(&FeePayerConfig{EnabledFeePayer: true, FeePayer: acc.Address}, policy, accounts.NewManager(ks), db)
+	fp.chainID = testChainID
+	fp.txPool = pool
+	return fp, pool, func() { os.RemoveAll(dir) }
+}
+
+func newTestTx(t *testing.T, prv *ecdsa.PrivateKey, nonce uint64, feePayer common.Address, to common.Address, input []byte, ratio types.FeeRatio) *types.Transaction {
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    nonce,
+		types.TxValueKeyFrom:     crypto.PubkeyToAddress(prv.PublicKey),
+		types.TxValueKeyTo:       to,
+		types.TxValueKeyAmount:   big.NewInt(0),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyData:     input,
+		types.TxValueKeyFeePayer: feePayer,
+	}
+	txType := types.TxTypeFeeDelegatedSmartContractExecution
+	if ratio != types.MaxFeeRatio {
+		txType = types.TxTypeFeeDelegatedSmartContractExecutionWithRatio
+		values[types.TxValueKeyFeeRatioOfFeePayer] = ratio
+	}
+	tx, err := types.NewTransactionWithMap(txType, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Sign(types.NewEIP155Signer(testChainID), prv); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestFeePayer_SignAndSend(t *testing.T) {
+	policy := &Policy{
+		AllowedContracts: map[common.Address][]hexutil.Bytes{testContract: {testMethod}},
+		MaxGas:           100000,
+		MaxFeeRatio:      50,
+		MaxTxsPerSender:  2,
+	}
+	fp, pool, closeFn := newTestFeePayer(t, policy, database.NewMemoryDBManager())
+	defer closeFn()
+
+	prv, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(prv.PublicKey)
+	feePayer := fp.config.FeePayer
+	input := append(common.CopyBytes(testMethod), make([]byte, 64)...)
+
+	// The transactions violating the policy are rejected.
+	_, err := fp.SignAndSend(newTestTx(t, prv, 0, common.HexToAddress("0x2"), testContract, input, 30))
+	assert.Equal(t, ErrFeePayerMismatch, err)
+	_, err = fp.SignAndSend(newTestTx(t, prv, 0, feePayer, common.HexToAddress("0x2"), input, 30))
+	assert.Equal(t, ErrContractNotAllowed, err)
+	_, err = fp.SignAndSend(newTestTx(t, prv, 0, feePayer, testContract, []byte{0x01, 0x02, 0x03, 0x04}, 30))
+	assert.Equal(t, ErrMethodNotAllowed, err)
+	_, err = fp.SignAndSend(newTestTx(t, prv, 0, feePayer, testContract, input, types.MaxFeeRatio))
+	assert.Equal(t, ErrFeeRatioExceeded, err)
+	assert.Equal(t, 0, len(pool.txs))
+
+	// The transaction within the policy is signed by the fee payer and submitted.
+	signed, err := fp.SignAndSend(newTestTx(t, prv, 0, feePayer, testContract, input, 30))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pool.txs))
+	pubkeys, err := types.NewEIP155Signer(testChainID).SenderFeePayer(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, feePayer, crypto.PubkeyToAddress(*pubkeys[0]))
+
+	usage := fp.Usage(sender)
+	assert.Equal(t, uint64(1), usage.Txs)
+	assert.Equal(t, big.NewInt(100000*25*30/100), usage.Fee)
+
+	// The usage is not updated if the transaction is not accepted by the pool.
+	pool.err = errors.New("rejected")
+	_, err = fp.SignAndSend(newTestTx(t, prv, 1, feePayer, testContract, input, 30))
+	assert.Equal(t, pool.err, err)
+	assert.Equal(t, uint64(1), fp.Usage(sender).Txs)
+
+	// The sender cannot exceed the quota.
+	pool.err = nil
+	_, err = fp.SignAndSend(newTestTx(t, prv, 1, feePayer, testContract, input, 30))
+	assert.NoError(t, err)
+	_, err = fp.SignAndSend(newTestTx(t, prv, 2, feePayer, testContract, input, 30))
+	assert.Equal(t, ErrTxQuotaExceeded, err)
+	assert.Equal(t, 2, len(pool.txs))
+}
+
+func TestFeePayer_UsagePersistence(t *testing.T) {
+	policy := &Policy{
+		AllowedContracts: map[common.Address][]hexutil.Bytes{testContract: nil},
+		MaxFeePerSender:  big.NewInt(100000 * 25 * 2),
+	}
+	db := database.NewMemoryDBManager()
+	fp, _, closeFn := newTestFeePayer(t, policy, db)
+	defer closeFn()
+
+	prv, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(prv.PublicKey)
+
+	_, err := fp.SignAndSend(newTestTx(t, prv, 0, fp.config.FeePayer, testContract, nil, types.MaxFeeRatio))
+	assert.NoError(t, err)
+
+	// Another service on the same database continues the accounting.
+	restarted := newFeePayer(fp.config, policy, fp.accountManager, db)
+	restarted.chainID = testChainID
+	restarted.txPool = &testTxPool{}
+	assert.Equal(t, uint64(1), restarted.Usage(sender).Txs)
+
+	_, err = restarted.SignAndSend(newTestTx(t, prv, 1, fp.config.FeePayer, testContract, nil, types.MaxFeeRatio))
+	assert.NoError(t, err)
+	_, err = restarted.SignAndSend(newTestTx(t, prv, 2, fp.config.FeePayer, testContract, nil, types.MaxFeeRatio))
+	assert.Equal(t, ErrFeeQuotaExceeded, err)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	f, err := ioutil.TempFile("", "klaytn-feepayer-policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`{
+  "allowedContracts": {"0x0000000000000000000000000000000000001000": ["0xa9059cbb"]},
+  "maxGas": 100000,
+  "maxFeeRatio": 50,
+  "quotaPeriod": 86400,
+  "maxTxsPerSender": 10,
+  "maxFeePerSender": 1000000000000000000
+}`)
+	f.Close()
+
+	policy, err := LoadPolicy(f.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, []hexutil.Bytes{testMethod}, policy.AllowedContracts[testContract])
+	assert.Equal(t, uint64(100000), policy.MaxGas)
+	assert.Equal(t, types.FeeRatio(50), policy.MaxFeeRatio)
+	assert.Equal(t, uint64(86400), policy.QuotaPeriod)
+	assert.Equal(t, uint64(10), policy.MaxTxsPerSender)
+	assert.Equal(t, new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil), policy.MaxFeePerSender)
+}
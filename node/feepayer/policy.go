@@ -0,0 +1,137 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feepayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"io/ioutil"
+	"math/big"
+	"time"
+)
+
+const methodSelectorLength = 4
+
+var (
+	ErrContractNotAllowed = errors.New("the recipient is not an allowed contract")
+	ErrMethodNotAllowed   = errors.New("the method is not allowed")
+	ErrGasLimitExceeded   = errors.New("the gas limit exceeds the policy")
+	ErrFeeRatioExceeded   = errors.New("the fee ratio exceeds the policy")
+	ErrTxQuotaExceeded    = errors.New("the sender exceeded the transaction quota")
+	ErrFeeQuotaExceeded   = errors.New("the sender exceeded the fee quota")
+)
+
+// Policy decides which fee-delegated transactions are paid by the fee payer service.
+// The zero value of each limit means no limit.
+//
+// A transaction is paid only if its recipient is one of AllowedContracts. If the selectors
+// of the methods are listed for the contract, the input of the transaction should call one
+// of them.
+//
+// The quotas are applied to each sender during every QuotaPeriod seconds. If QuotaPeriod is
+// zero, the quotas are applied to the whole lifetime of the service.
+type Policy struct {
+	AllowedContracts map[common.Address][]hexutil.Bytes `json:"allowedContracts"`
+	MaxGas           uint64                             `json:"maxGas"`
+	MaxFeeRatio      types.FeeRatio                     `json:"maxFeeRatio"`
+	QuotaPeriod      uint64                             `json:"quotaPeriod"`
+	MaxTxsPerSender  uint64                             `json:"maxTxsPerSender"`
+	MaxFeePerSender  *big.Int                           `json:"maxFeePerSender"`
+}
+
+// LoadPolicy reads the policy from the JSON file.
+func LoadPolicy(file string) (*Policy, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	policy := new(Policy)
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Check returns an error if the transaction is not allowed by the policy regardless of the quotas.
+func (p *Policy) Check(tx *types.Transaction) error {
+	to := tx.To()
+	if to == nil {
+		return ErrContractNotAllowed
+	}
+	methods, ok := p.AllowedContracts[*to]
+	if !ok {
+		return ErrContractNotAllowed
+	}
+	if len(methods) > 0 {
+		input := tx.Data()
+		if len(input) < methodSelectorLength {
+			return ErrMethodNotAllowed
+		}
+		allowed := false
+		for _, method := range methods {
+			if bytes.Equal(method, input[:methodSelectorLength]) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrMethodNotAllowed
+		}
+	}
+
+	if p.MaxGas != 0 && tx.Gas() > p.MaxGas {
+		return ErrGasLimitExceeded
+	}
+	if ratio, _ := tx.FeeRatio(); p.MaxFeeRatio != 0 && ratio > p.MaxFeeRatio {
+		return ErrFeeRatioExceeded
+	}
+	return nil
+}
+
+// CheckQuota returns an error if the sender exceeds the quotas by sending the transaction.
+func (p *Policy) CheckQuota(usage *Usage, tx *types.Transaction) error {
+	if p.MaxTxsPerSender != 0 && usage.Txs+1 > p.MaxTxsPerSender {
+		return ErrTxQuotaExceeded
+	}
+	if p.MaxFeePerSender != nil {
+		fee := new(big.Int).Add(usage.Fee, maxFeeOf(tx))
+		if fee.Cmp(p.MaxFeePerSender) > 0 {
+			return ErrFeeQuotaExceeded
+		}
+	}
+	return nil
+}
+
+// Period returns the quota period containing the given time.
+func (p *Policy) Period(now time.Time) uint64 {
+	if p.QuotaPeriod == 0 {
+		return 0
+	}
+	return uint64(now.Unix()) / p.QuotaPeriod
+}
+
+// maxFeeOf returns the maximum fee of the transaction paid by the fee payer.
+func maxFeeOf(tx *types.Transaction) *big.Int {
+	ratio, _ := tx.FeeRatio()
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice())
+	fee.Mul(fee, big.NewInt(int64(ratio)))
+	return fee.Div(fee, big.NewInt(int64(types.MaxFeeRatio)))
+}
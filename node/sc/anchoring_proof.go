@@ -0,0 +1,239 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"errors"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/statedb"
+	"math/big"
+)
+
+var (
+	ErrTxNotFound                 = errors.New("transaction is not found")
+	ErrNotAnchoredYet             = errors.New("the block of the transaction is not anchored yet")
+	ErrUnsupportedDeriveShaImpl   = errors.New("the inclusion proof is only supported with the original DeriveSha")
+	ErrInvalidAnchoringProof      = errors.New("invalid anchoring proof")
+	ErrAnchoringProofDataMismatch = errors.New("the anchoring proof does not match the anchored data")
+	ErrAnchoringTxPending         = errors.New("the anchoring transaction is not mined yet")
+	ErrAnchoringTxFailed          = errors.New("the anchoring transaction is failed")
+	ErrUnexpectedAnchoringSender  = errors.New("the anchoring transaction is not sent by the expected address")
+)
+
+// AnchoringProof proves that a child chain transaction and its receipt are included in
+// a child chain block which is anchored to the parent chain, by the trie proofs of DeriveSha
+// and the headers linking the block of the transaction to the anchored block.
+// If the blocks are anchored in batch, BlockProof locates Headers[0] in the anchored range instead.
+type AnchoringProof struct {
+	TxHash            common.Hash     `json:"txHash"`
+	TxIndex           uint64          `json:"txIndex"`
+	Headers           []*types.Header `json:"headers"` // from the anchored block to the block of the transaction.
+	BlockProof        []common.Hash   `json:"blockProof"`
+	TxProof           []hexutil.Bytes `json:"txProof"`
+	ReceiptProof      []hexutil.Bytes `json:"receiptProof"`
+	AnchoringTxHash   common.Hash     `json:"anchoringTxHash"`   // the parent chain transaction which anchors Headers[0].
+	AnchoredBlockHash common.Hash     `json:"anchoredBlockHash"` // the block anchored by AnchoringTxHash, the last block of the range if anchored in batch.
+}
+
+// anchoredBlockHash returns the hash of the child chain block by which the anchoring transaction
+// is indexed in the parent chain. It is the last block of the anchored range if the blocks are
+// anchored in batch, which is not one of the headers of the proof.
+func (proof *AnchoringProof) anchoredBlockHash() common.Hash {
+	if len(proof.BlockProof) > 0 || proof.AnchoredBlockHash != (common.Hash{}) {
+		return proof.AnchoredBlockHash
+	}
+	return proof.Headers[0].Hash()
+}
+
+// newAnchoringProof makes the anchoring proof of the given child chain transaction.
+// The anchored block is the first block of the anchoring period at or after the block of the transaction.
+func newAnchoringProof(bc *blockchain.BlockChain, db anchoringReceiptReader, txHash common.Hash, period uint64, batch bool) (*AnchoringProof, error) {
+	if bc.Config().DeriveShaImpl != types.ImplDeriveShaOriginal {
+		return nil, ErrUnsupportedDeriveShaImpl
+	}
+
+	tx, blockHash, blockNumber, index := bc.GetTxAndLookupInfo(txHash)
+	if tx == nil {
+		return nil, ErrTxNotFound
+	}
+	block := bc.GetBlockByHash(blockHash)
+	if block == nil {
+		return nil, ErrTxNotFound
+	}
+	receipts := bc.GetReceiptsByBlockHash(blockHash)
+
+	anchoredNumber := blockNumber
+	if period > 1 && blockNumber%period != 0 {
+		anchoredNumber += period - blockNumber%period
+	}
+	anchored := bc.GetHeaderByNumber(anchoredNumber)
+	if anchored == nil {
+		return nil, ErrNotAnchoredYet
+	}
+	receipt := db.ReadReceiptFromParentChain(anchored.Hash())
+	if receipt == nil {
+		return nil, ErrNotAnchoredYet
+	}
+
+	var headers []*types.Header
+	var blockProof []common.Hash
+	if batch {
+		batchHeaders := getAnchoringBatchHeaders(bc, anchored, period)
+		hashes := make([]common.Hash, len(batchHeaders))
+		for i, header := range batchHeaders {
+			hashes[i] = header.Hash()
+		}
+		index := len(batchHeaders) - int(anchoredNumber-blockNumber) - 1
+		if index < 0 || hashes[index] != blockHash {
+			return nil, ErrTxNotFound
+		}
+		headers = []*types.Header{batchHeaders[index]}
+		blockProof = types.BlockHashesProof(hashes, index)
+	} else {
+		headers = []*types.Header{anchored}
+		for h := anchored; h.Hash() != blockHash; {
+			if h = bc.GetHeaderByHash(h.ParentHash); h == nil {
+				return nil, ErrTxNotFound
+			}
+			headers = append(headers, h)
+		}
+	}
+
+	txProof, err := statedb.DeriveShaOrigProof(block.Transactions(), int(index))
+	if err != nil {
+		return nil, err
+	}
+	receiptProof, err := statedb.DeriveShaOrigProof(receipts, int(index))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnchoringProof{
+		TxHash:            txHash,
+		TxIndex:           index,
+		Headers:           headers,
+		BlockProof:        blockProof,
+		TxProof:           toHexBytes(txProof),
+		ReceiptProof:      toHexBytes(receiptProof),
+		AnchoringTxHash:   receipt.TxHash,
+		AnchoredBlockHash: anchored.Hash(),
+	}, nil
+}
+
+// anchoringReceiptReader reads the receipt of the anchoring transaction received from the parent chain.
+type anchoringReceiptReader interface {
+	ReadReceiptFromParentChain(blockHash common.Hash) *types.Receipt
+}
+
+// VerifyAnchoringProof verifies the anchoring proof with the given chain data anchoring transaction
+// of the parent chain and its receipt. The anchoring transaction should be sent by the given
+// anchoring address of the child chain, since anyone can anchor any data to the parent chain.
+// It returns the proven child chain transaction and its receipt.
+func VerifyAnchoringProof(proof *AnchoringProof, anchoringTx *types.Transaction, anchoringReceipt *types.Receipt, anchoringAddr common.Address) (*types.Transaction, *types.Receipt, error) {
+	if len(proof.Headers) == 0 {
+		return nil, nil, ErrInvalidAnchoringProof
+	}
+	if anchoringReceipt == nil {
+		return nil, nil, ErrAnchoringTxPending
+	}
+	if anchoringReceipt.Status != types.ReceiptStatusSuccessful {
+		return nil, nil, ErrAnchoringTxFailed
+	}
+	if from, err := anchoringTx.From(); err != nil || from != anchoringAddr {
+		return nil, nil, ErrUnexpectedAnchoringSender
+	}
+
+	data, err := anchoringTx.AnchoredData()
+	if err != nil {
+		return nil, nil, err
+	}
+	anchoringData, err := types.DecodeAnchoringData(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anchored := proof.Headers[0]
+	switch anchoringData := anchoringData.(type) {
+	case *types.AnchoringDataLegacy:
+		if anchored.Hash() != anchoringData.BlockHash || anchored.TxHash != anchoringData.TxHash || anchored.ReceiptHash != anchoringData.ReceiptHash {
+			return nil, nil, ErrAnchoringProofDataMismatch
+		}
+	case *types.AnchoringDataInternalType0:
+		if anchored.Hash() != anchoringData.BlockHash || anchored.TxHash != anchoringData.TxHash || anchored.ReceiptHash != anchoringData.ReceiptHash {
+			return nil, nil, ErrAnchoringProofDataMismatch
+		}
+	case *types.AnchoringDataInternalType1:
+		if anchored.Number.Cmp(anchoringData.StartBlockNumber) < 0 {
+			return nil, nil, ErrAnchoringProofDataMismatch
+		}
+		index := new(big.Int).Sub(anchored.Number, anchoringData.StartBlockNumber)
+		if !index.IsInt64() || !anchoringData.BlockCount.IsInt64() ||
+			!types.VerifyBlockHashesProof(anchoringData.BlocksRoot, anchored.Hash(), int(index.Int64()), int(anchoringData.BlockCount.Int64()), proof.BlockProof) {
+			return nil, nil, ErrAnchoringProofDataMismatch
+		}
+	}
+	for i := 1; i < len(proof.Headers); i++ {
+		if proof.Headers[i-1].ParentHash != proof.Headers[i].Hash() {
+			return nil, nil, ErrInvalidAnchoringProof
+		}
+	}
+	header := proof.Headers[len(proof.Headers)-1]
+
+	encodedTx, err := statedb.VerifyDeriveShaOrigProof(header.TxHash, int(proof.TxIndex), fromHexBytes(proof.TxProof))
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return nil, nil, err
+	}
+	if tx.Hash() != proof.TxHash {
+		return nil, nil, ErrInvalidAnchoringProof
+	}
+
+	encodedReceipt, err := statedb.VerifyDeriveShaOrigProof(header.ReceiptHash, int(proof.TxIndex), fromHexBytes(proof.ReceiptProof))
+	if err != nil {
+		return nil, nil, err
+	}
+	receipt := new(types.Receipt)
+	if err := rlp.DecodeBytes(encodedReceipt, receipt); err != nil {
+		return nil, nil, err
+	}
+	receipt.TxHash = tx.Hash()
+
+	return tx, receipt, nil
+}
+
+func toHexBytes(list [][]byte) []hexutil.Bytes {
+	res := make([]hexutil.Bytes, len(list))
+	for i, b := range list {
+		res[i] = b
+	}
+	return res
+}
+
+func fromHexBytes(list []hexutil.Bytes) [][]byte {
+	res := make([][]byte, len(list))
+	for i, b := range list {
+		res[i] = b
+	}
+	return res
+}
@@ -0,0 +1,220 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"encoding/json"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/statedb"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// newTestAnchoringTx returns a chain data anchoring transaction of the given block.
+func newTestAnchoringTx(t *testing.T, block *types.Block) *types.Transaction {
+	anchoringData, err := types.NewAnchoringDataType0(block, big.NewInt(2), big.NewInt(int64(block.Transactions().Len())))
+	assert.NoError(t, err)
+	return newTestAnchoringTxWithData(t, anchoringData)
+}
+
+// testAnchoringAddr is the sender of the test anchoring transactions.
+var testAnchoringAddr = common.HexToAddress("0x1")
+
+// newTestAnchoringReceipt returns the successful receipt of the given anchoring transaction.
+func newTestAnchoringReceipt(anchoringTx *types.Transaction) *types.Receipt {
+	return types.NewReceipt(types.ReceiptStatusSuccessful, anchoringTx.Hash(), 100000)
+}
+
+// verifyTestAnchoringProof verifies the proof with the successful receipt of the test anchoring transaction.
+func verifyTestAnchoringProof(proof *AnchoringProof, anchoringTx *types.Transaction) (*types.Transaction, *types.Receipt, error) {
+	return VerifyAnchoringProof(proof, anchoringTx, newTestAnchoringReceipt(anchoringTx), testAnchoringAddr)
+}
+
+// newTestAnchoringTxWithData returns a chain data anchoring transaction of the given anchoring data.
+func newTestAnchoringTxWithData(t *testing.T, anchoringData *types.AnchoringData) *types.Transaction {
+	encodedData, err := rlp.EncodeToBytes(anchoringData)
+	assert.NoError(t, err)
+
+	tx, err := types.NewTransactionWithMap(types.TxTypeChainDataAnchoring, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:        uint64(0),
+		types.TxValueKeyFrom:         testAnchoringAddr,
+		types.TxValueKeyGasLimit:     uint64(100000),
+		types.TxValueKeyGasPrice:     big.NewInt(0),
+		types.TxValueKeyAnchoredData: encodedData,
+	})
+	assert.NoError(t, err)
+	return tx
+}
+
+// TestVerifyAnchoringProof checks if a child chain transaction is proven with the anchoring
+// transaction of a later block of the anchoring period.
+func TestVerifyAnchoringProof(t *testing.T) {
+	blockchain.InitDeriveSha(types.ImplDeriveShaOriginal)
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	signer := types.NewEIP155Signer(big.NewInt(1000))
+
+	var txs types.Transactions
+	var receipts types.Receipts
+	for i := 0; i < 3; i++ {
+		tx := types.NewTransaction(uint64(i), common.HexToAddress("0x2"), big.NewInt(int64(i)), 21000, big.NewInt(0), nil)
+		tx, err = types.SignTx(tx, signer, key)
+		assert.NoError(t, err)
+		txs = append(txs, tx)
+		receipts = append(receipts, types.NewReceipt(types.ReceiptStatusSuccessful, tx.Hash(), 21000))
+	}
+
+	txBlock := types.NewBlock(&types.Header{Number: big.NewInt(1), BlockScore: big.NewInt(1), Time: big.NewInt(1)}, txs, receipts)
+	anchoredBlock := types.NewBlock(&types.Header{ParentHash: txBlock.Hash(), Number: big.NewInt(2), BlockScore: big.NewInt(1), Time: big.NewInt(2)}, nil, nil)
+
+	index := 1
+	txProof, err := statedb.DeriveShaOrigProof(txs, index)
+	assert.NoError(t, err)
+	receiptProof, err := statedb.DeriveShaOrigProof(receipts, index)
+	assert.NoError(t, err)
+
+	proof := &AnchoringProof{
+		TxHash:       txs[index].Hash(),
+		TxIndex:      uint64(index),
+		Headers:      []*types.Header{anchoredBlock.Header(), txBlock.Header()},
+		TxProof:      toHexBytes(txProof),
+		ReceiptProof: toHexBytes(receiptProof),
+	}
+	assert.Equal(t, anchoredBlock.Hash(), proof.anchoredBlockHash())
+
+	// The proof is delivered in JSON through the APIs.
+	encoded, err := json.Marshal(proof)
+	assert.NoError(t, err)
+	decoded := new(AnchoringProof)
+	assert.NoError(t, json.Unmarshal(encoded, decoded))
+
+	anchoringTx := newTestAnchoringTx(t, anchoredBlock)
+	anchoringReceipt := newTestAnchoringReceipt(anchoringTx)
+	tx, receipt, err := VerifyAnchoringProof(decoded, anchoringTx, anchoringReceipt, testAnchoringAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, txs[index].Hash(), tx.Hash())
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// The anchoring transaction should be sent by the anchoring address.
+	_, _, err = VerifyAnchoringProof(decoded, anchoringTx, anchoringReceipt, common.HexToAddress("0x3"))
+	assert.Equal(t, ErrUnexpectedAnchoringSender, err)
+
+	// The anchoring transaction should be mined successfully.
+	_, _, err = VerifyAnchoringProof(decoded, anchoringTx, nil, testAnchoringAddr)
+	assert.Equal(t, ErrAnchoringTxPending, err)
+	failedReceipt := types.NewReceipt(types.ReceiptStatusErrDefault, anchoringTx.Hash(), 100000)
+	_, _, err = VerifyAnchoringProof(decoded, anchoringTx, failedReceipt, testAnchoringAddr)
+	assert.Equal(t, ErrAnchoringTxFailed, err)
+
+	// The anchoring transaction of the other block cannot prove it.
+	_, _, err = verifyTestAnchoringProof(decoded, newTestAnchoringTx(t, txBlock))
+	assert.Equal(t, ErrAnchoringProofDataMismatch, err)
+
+	// The headers should be linked.
+	unlinked := *decoded
+	unlinked.Headers = []*types.Header{anchoredBlock.Header(), anchoredBlock.Header()}
+	_, _, err = verifyTestAnchoringProof(&unlinked, newTestAnchoringTx(t, anchoredBlock))
+	assert.Equal(t, ErrInvalidAnchoringProof, err)
+
+	// The proof of the other transaction cannot prove it.
+	otherProof, err := statedb.DeriveShaOrigProof(txs, 0)
+	assert.NoError(t, err)
+	wrongTx := *decoded
+	wrongTx.TxIndex = 0
+	wrongTx.TxProof = toHexBytes(otherProof)
+	_, _, err = verifyTestAnchoringProof(&wrongTx, newTestAnchoringTx(t, anchoredBlock))
+	assert.Equal(t, ErrInvalidAnchoringProof, err)
+}
+
+type testHeaderReader map[common.Hash]*types.Header
+
+func (r testHeaderReader) GetHeaderByHash(hash common.Hash) *types.Header { return r[hash] }
+
+// TestVerifyBatchAnchoringProof checks if a child chain transaction is proven with the anchoring
+// transaction which anchors all blocks of the anchoring period.
+func TestVerifyBatchAnchoringProof(t *testing.T) {
+	blockchain.InitDeriveSha(types.ImplDeriveShaOriginal)
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	signer := types.NewEIP155Signer(big.NewInt(1000))
+
+	reader := testHeaderReader{}
+	var blocks []*types.Block
+	parentHash := common.Hash{}
+	for i := int64(1); i <= 4; i++ {
+		tx := types.NewTransaction(uint64(i), common.HexToAddress("0x2"), big.NewInt(i), 21000, big.NewInt(0), nil)
+		tx, err = types.SignTx(tx, signer, key)
+		assert.NoError(t, err)
+		receipt := types.NewReceipt(types.ReceiptStatusSuccessful, tx.Hash(), 21000)
+
+		block := types.NewBlock(&types.Header{ParentHash: parentHash, Number: big.NewInt(i), BlockScore: big.NewInt(1), Time: big.NewInt(i)},
+			types.Transactions{tx}, types.Receipts{receipt})
+		blocks = append(blocks, block)
+		reader[block.Hash()] = block.Header()
+		parentHash = block.Hash()
+	}
+
+	// The period is 4, so the block 4 anchors the blocks from 1 to 4.
+	headers := getAnchoringBatchHeaders(reader, blocks[3].Header(), 4)
+	if !assert.Equal(t, 4, len(headers)) {
+		return
+	}
+	assert.Equal(t, blocks[0].Hash(), headers[0].Hash())
+
+	anchoringData, err := types.NewAnchoringDataType1(headers, big.NewInt(4))
+	assert.NoError(t, err)
+	anchoringTx := newTestAnchoringTxWithData(t, anchoringData)
+
+	hashes := []common.Hash{blocks[0].Hash(), blocks[1].Hash(), blocks[2].Hash(), blocks[3].Hash()}
+	txProof, err := statedb.DeriveShaOrigProof(blocks[1].Transactions(), 0)
+	assert.NoError(t, err)
+	receiptProof, err := statedb.DeriveShaOrigProof(types.Receipts{types.NewReceipt(types.ReceiptStatusSuccessful, blocks[1].Transactions()[0].Hash(), 21000)}, 0)
+	assert.NoError(t, err)
+
+	proof := &AnchoringProof{
+		TxHash:       blocks[1].Transactions()[0].Hash(),
+		Headers:      []*types.Header{blocks[1].Header()},
+		BlockProof:   types.BlockHashesProof(hashes, 1),
+		TxProof:      toHexBytes(txProof),
+		ReceiptProof: toHexBytes(receiptProof),
+	}
+	tx, _, err := verifyTestAnchoringProof(proof, anchoringTx)
+	assert.NoError(t, err)
+	assert.Equal(t, proof.TxHash, tx.Hash())
+
+	// The anchoring transaction of the batch is looked up by the last block of the batch.
+	assert.Equal(t, common.Hash{}, proof.anchoredBlockHash())
+	proof.AnchoredBlockHash = blocks[3].Hash()
+	data, err := anchoringTx.AnchoredData()
+	assert.NoError(t, err)
+	decoded, err := types.DecodeAnchoringData(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, decoded.GetBlockHash(), proof.anchoredBlockHash())
+	}
+
+	// The block proof should locate the block in the batch.
+	proof.BlockProof = types.BlockHashesProof(hashes, 2)
+	_, _, err = verifyTestAnchoringProof(proof, anchoringTx)
+	assert.Equal(t, ErrAnchoringProofDataMismatch, err)
+}
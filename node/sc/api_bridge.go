@@ -30,7 +30,9 @@ import (
 )
 
 var (
-	ErrInvalidBridgePair = errors.New("invalid bridge pair")
+	ErrInvalidBridgePair          = errors.New("invalid bridge pair")
+	ErrNoValueTransferStatus      = errors.New("value transfer status does not exist")
+	ErrInvalidValueTransferStatus = errors.New("invalid value transfer status")
 )
 
 // MainBridgeAPI Implementation for main-bridge node
@@ -46,6 +48,40 @@ func (mb *MainBridgeAPI) ConvertChildChainBlockHashToParentChainTxHash(scBlockHa
 	return mb.mainBridge.eventhandler.ConvertChildChainBlockHashToParentChainTxHash(scBlockHash)
 }
 
+// VerifyAnchoringProof verifies the given anchoring proof of a child chain transaction with
+// the chain data anchoring transaction on the parent chain, which should be sent by the given
+// anchoring address. It returns the anchoring transaction and the proven child chain transaction
+// and receipt.
+func (mb *MainBridgeAPI) VerifyAnchoringProof(proof AnchoringProof, anchoringAddr common.Address) (map[string]interface{}, error) {
+	if len(proof.Headers) == 0 {
+		return nil, ErrInvalidAnchoringProof
+	}
+
+	anchoringTxHash := proof.AnchoringTxHash
+	if anchoringTxHash == (common.Hash{}) {
+		anchoringTxHash = mb.mainBridge.eventhandler.ConvertChildChainBlockHashToParentChainTxHash(proof.anchoredBlockHash())
+	}
+	anchoringTx, blockHash, blockNumber, _ := mb.mainBridge.blockchain.GetTxAndLookupInfo(anchoringTxHash)
+	if anchoringTx == nil {
+		return nil, ErrTxNotFound
+	}
+
+	anchoringReceipt := mb.mainBridge.blockchain.GetReceiptByTxHash(anchoringTxHash)
+
+	tx, receipt, err := VerifyAnchoringProof(&proof, anchoringTx, anchoringReceipt, anchoringAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"anchoringTx":          anchoringTx,
+		"anchoringBlockHash":   blockHash,
+		"anchoringBlockNumber": blockNumber,
+		"tx":                   tx,
+		"receipt":              receipt,
+	}, nil
+}
+
 // Peers retrieves all the information we know about each individual peer at the
 // protocol granularity.
 func (mb *MainBridgeAPI) Peers() ([]*p2p.PeerInfo, error) {
@@ -83,6 +119,12 @@ func (sb *SubBridgeAPI) GetReceiptFromParentChain(blockHash common.Hash) *types.
 	return sb.subBridge.handler.GetReceiptFromParentChain(blockHash)
 }
 
+// GetAnchoringProof returns the proof that the given child chain transaction and its receipt
+// are included in a block anchored to the parent chain.
+func (sb *SubBridgeAPI) GetAnchoringProof(txHash common.Hash) (*AnchoringProof, error) {
+	return newAnchoringProof(sb.subBridge.blockchain, sb.subBridge.chainDB, txHash, sb.subBridge.handler.GetAnchoringPeriod(), sb.subBridge.config.AnchoringBatch)
+}
+
 func (sb *SubBridgeAPI) DeployBridge() ([]common.Address, error) {
 	cBridge, cBridgeAddr, err := sb.subBridge.bridgeManager.DeployBridge(sb.subBridge.localBackend, true)
 	if err != nil {
@@ -168,6 +210,57 @@ func (sb *SubBridgeAPI) ConvertRequestTxHashToHandleTxHash(hash common.Hash) com
 	return sb.subBridge.chainDB.ReadHandleTxHashFromRequestTxHash(hash)
 }
 
+// GetValueTransferStatus returns the status of the value transfer requested to the given bridge with the given request nonce.
+func (sb *SubBridgeAPI) GetValueTransferStatus(bridgeAddr common.Address, requestNonce uint64) (*ValueTransferStatus, error) {
+	status := ReadValueTransferStatus(sb.subBridge.chainDB, bridgeAddr, requestNonce)
+	if status == nil {
+		return nil, ErrNoValueTransferStatus
+	}
+	return status, nil
+}
+
+// GetValueTransferStatusesBySender returns the statuses of the latest value transfers requested to the given bridge by the sender.
+func (sb *SubBridgeAPI) GetValueTransferStatusesBySender(bridgeAddr, sender common.Address) ([]*ValueTransferStatus, error) {
+	return sb.findValueTransferStatuses(bridgeAddr, func(status *ValueTransferStatus) bool {
+		return status.From == sender
+	})
+}
+
+// GetValueTransferStatusesByToken returns the statuses of the latest value transfers of the token requested to the given bridge.
+func (sb *SubBridgeAPI) GetValueTransferStatusesByToken(bridgeAddr, tokenAddr common.Address) ([]*ValueTransferStatus, error) {
+	return sb.findValueTransferStatuses(bridgeAddr, func(status *ValueTransferStatus) bool {
+		return status.TokenAddress == tokenAddr
+	})
+}
+
+// GetValueTransferStatusesByStatus returns the latest value transfers requested to the given bridge which have the given status.
+func (sb *SubBridgeAPI) GetValueTransferStatusesByStatus(bridgeAddr common.Address, status string) ([]*ValueTransferStatus, error) {
+	switch status {
+	case VTStatusRequested, VTStatusVoted, VTStatusHandled, VTStatusFailed:
+	default:
+		return nil, ErrInvalidValueTransferStatus
+	}
+	return sb.findValueTransferStatuses(bridgeAddr, func(s *ValueTransferStatus) bool {
+		return s.Status == status
+	})
+}
+
+// findValueTransferStatuses looks up the statuses of the value transfers requested to the given bridge
+// by using the counterpart bridge which handles them.
+func (sb *SubBridgeAPI) findValueTransferStatuses(bridgeAddr common.Address, match func(status *ValueTransferStatus) bool) ([]*ValueTransferStatus, error) {
+	ctBridge := sb.subBridge.bridgeManager.GetCounterPartBridgeAddr(bridgeAddr)
+	if ctBridge == (common.Address{}) {
+		return nil, ErrInvalidBridgePair
+	}
+
+	bi, ok := sb.subBridge.bridgeManager.GetBridgeInfo(ctBridge)
+	if !ok {
+		return nil, ErrNoBridgeInfo
+	}
+
+	return bi.FindValueTransferStatuses(match), nil
+}
+
 func (sb *SubBridgeAPI) TxPendingCount() int {
 	return sb.subBridge.GetBridgeTxPool().Stats()
 }
@@ -327,6 +420,10 @@ func (sb *SubBridgeAPI) SetERC20Fee(bridgeAddr, tokenAddr common.Address, fee *b
 	return sb.subBridge.bridgeManager.SetERC20Fee(bridgeAddr, tokenAddr, fee)
 }
 
+func (sb *SubBridgeAPI) SetERC1155Fee(bridgeAddr, tokenAddr common.Address, fee *big.Int) (common.Hash, error) {
+	return sb.subBridge.bridgeManager.SetERC1155Fee(bridgeAddr, tokenAddr, fee)
+}
+
 func (sb *SubBridgeAPI) SetKLAYFee(bridgeAddr common.Address, fee *big.Int) (common.Hash, error) {
 	return sb.subBridge.bridgeManager.SetKLAYFee(bridgeAddr, fee)
 }
@@ -339,6 +436,10 @@ func (sb *SubBridgeAPI) GetERC20Fee(bridgeAddr, tokenAddr common.Address) (*big.
 	return sb.subBridge.bridgeManager.GetERC20Fee(bridgeAddr, tokenAddr)
 }
 
+func (sb *SubBridgeAPI) GetERC1155Fee(bridgeAddr, tokenAddr common.Address) (*big.Int, error) {
+	return sb.subBridge.bridgeManager.GetERC1155Fee(bridgeAddr, tokenAddr)
+}
+
 func (sb *SubBridgeAPI) GetKLAYFee(bridgeAddr common.Address) (*big.Int, error) {
 	return sb.subBridge.bridgeManager.GetKLAYFee(bridgeAddr)
 }
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/accounts/external"
 	"github.com/klaytn/klaytn/accounts/keystore"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/cmd/homi/setup"
@@ -40,11 +41,13 @@ const (
 
 var (
 	errUnlockDurationTooLarge = errors.New("unlock duration too large")
+	errNoSignerAccount        = errors.New("the external signer has no account")
 )
 
 // accountInfo has bridge account's information to make and sign a transaction.
 type accountInfo struct {
 	keystore *keystore.KeyStore
+	signer   accounts.Wallet // external signer used instead of the keystore if it is set
 	address  common.Address
 	nonce    uint64
 	chainID  *big.Int
@@ -72,45 +75,62 @@ func (ba *BridgeAccounts) GetBridgeOperators() map[string]interface{} {
 
 // NewBridgeAccounts returns bridgeAccounts created by main/service bridge account keys.
 func NewBridgeAccounts(dataDir string) (*BridgeAccounts, error) {
-	pKS, pAccAddr, isLock, err := InitializeBridgeAccountKeystore(path.Join(dataDir, "parent_bridge_account"))
+	return NewBridgeAccountsWithSigners(dataDir, "", "")
+}
+
+// NewBridgeAccountsWithSigners returns bridgeAccounts created by main/service bridge account keys.
+// If the endpoint of an external signer is given, the first account of the signer is used
+// as the bridge account instead of the key in the data directory.
+func NewBridgeAccountsWithSigners(dataDir string, parentSigner, childSigner string) (*BridgeAccounts, error) {
+	pAccInfo, err := newAccountInfo(path.Join(dataDir, "parent_bridge_account"), parentSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	if isLock {
-		logger.Warn("parent_bridge_account is locked. Please unlock the account manually for Service Chain")
-	}
-
-	cKS, cAccAddr, isLock, err := InitializeBridgeAccountKeystore(path.Join(dataDir, "child_bridge_account"))
+	cAccInfo, err := newAccountInfo(path.Join(dataDir, "child_bridge_account"), childSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	if isLock {
-		logger.Warn("child_bridge_account is locked. Please unlock the account manually for Service Chain")
-	}
+	logger.Info("bridge account is loaded", "parent", pAccInfo.address.String(), "child", cAccInfo.address.String())
 
-	logger.Info("bridge account is loaded", "parent", pAccAddr.String(), "child", cAccAddr.String())
+	return &BridgeAccounts{
+		pAccount: pAccInfo,
+		cAccount: cAccInfo,
+	}, nil
+}
 
-	pAccInfo := &accountInfo{
-		keystore: pKS,
-		address:  pAccAddr,
-		nonce:    0,
-		chainID:  nil,
-		gasPrice: nil,
+// newAccountInfo returns the accountInfo of the 1st account of the external signer if the endpoint is given.
+// Otherwise, it returns the one of the keystore in the keystore path.
+func newAccountInfo(keystorePath string, signerEndpoint string) (*accountInfo, error) {
+	if signerEndpoint != "" {
+		signer, err := external.NewExternalSigner(signerEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		accs := signer.Accounts()
+		if len(accs) == 0 {
+			signer.Close()
+			return nil, errNoSignerAccount
+		}
+		return &accountInfo{
+			signer:  signer,
+			address: accs[0].Address,
+		}, nil
 	}
 
-	cAccInfo := &accountInfo{
-		keystore: cKS,
-		address:  cAccAddr,
-		nonce:    0,
-		chainID:  nil,
-		gasPrice: nil,
+	ks, accAddr, isLock, err := InitializeBridgeAccountKeystore(keystorePath)
+	if err != nil {
+		return nil, err
 	}
 
-	return &BridgeAccounts{
-		pAccount: pAccInfo,
-		cAccount: cAccInfo,
+	if isLock {
+		logger.Warn(path.Base(keystorePath) + " is locked. Please unlock the account manually for Service Chain")
+	}
+
+	return &accountInfo{
+		keystore: ks,
+		address:  accAddr,
 	}, nil
 }
 
@@ -178,11 +198,17 @@ func (acc *accountInfo) GetTransactOpts() *bind.TransactOpts {
 		nonce = new(big.Int).SetUint64(acc.nonce)
 	}
 
+	if acc.signer != nil {
+		return bind.MakeTransactOptsWithWallet(acc.signer, acc.address, nonce, acc.chainID, DefaultBridgeTxGasLimit, acc.gasPrice)
+	}
 	return bind.MakeTransactOptsWithKeystore(acc.keystore, acc.address, nonce, acc.chainID, DefaultBridgeTxGasLimit, acc.gasPrice)
 }
 
 // SignTx signs a transaction with the accountInfo.
 func (acc *accountInfo) SignTx(tx *types.Transaction) (*types.Transaction, error) {
+	if acc.signer != nil {
+		return acc.signer.SignTx(accounts.Account{Address: acc.address}, tx, acc.chainID)
+	}
 	return acc.keystore.SignTx(accounts.Account{Address: acc.address}, tx, acc.chainID)
 }
 
@@ -223,7 +249,12 @@ func (acc *accountInfo) IncNonce() {
 }
 
 // LockAccount can lock the account keystore.
+// The account of an external signer cannot be locked.
 func (acc *accountInfo) LockAccount() error {
+	if acc.signer != nil {
+		return accounts.ErrNotSupported
+	}
+
 	acc.mu.Lock()
 	defer acc.mu.Unlock()
 
@@ -236,7 +267,12 @@ func (acc *accountInfo) LockAccount() error {
 }
 
 // UnLockAccount can unlock the account keystore.
+// The account of an external signer cannot be unlocked.
 func (acc *accountInfo) UnLockAccount(passphrase string, duration *uint64) error {
+	if acc.signer != nil {
+		return accounts.ErrNotSupported
+	}
+
 	acc.mu.Lock()
 	defer acc.mu.Unlock()
 
@@ -259,7 +295,12 @@ func (acc *accountInfo) UnLockAccount(passphrase string, duration *uint64) error
 }
 
 // IsUnlockedAccount can return if the account is unlocked or not.
+// The account of an external signer is always regarded as unlocked.
 func (acc *accountInfo) IsUnlockedAccount() bool {
+	if acc.signer != nil {
+		return true
+	}
+
 	acc.mu.Lock()
 	defer acc.mu.Unlock()
 	return acc.keystore.IsUnlocked(acc.address)
@@ -44,6 +44,8 @@ const (
 	KLAY uint8 = iota
 	ERC20
 	ERC721
+	ERC1155
+	MESSAGE // the message transfer which delivers ExtraData to the To contract.
 )
 
 const (
@@ -64,6 +66,27 @@ var (
 // RequestValueTransferEvent from Bridge contract
 type RequestValueTransferEvent struct {
 	*bridgecontract.BridgeRequestValueTransfer
+	Amount *big.Int // the amount of ERC1155 tokens. It is nil for the other token types.
+}
+
+// newRequestERC1155TransferEvent converts the RequestERC1155Transfer event of the Bridge contract
+// to a RequestValueTransferEvent so that it is ordered and handled with the other request events.
+func newRequestERC1155TransferEvent(ev *bridgecontract.BridgeRequestERC1155Transfer) *RequestValueTransferEvent {
+	return &RequestValueTransferEvent{
+		BridgeRequestValueTransfer: &bridgecontract.BridgeRequestValueTransfer{
+			TokenType:      ERC1155,
+			From:           ev.From,
+			To:             ev.To,
+			TokenAddress:   ev.TokenAddress,
+			ValueOrTokenId: ev.TokenId,
+			RequestNonce:   ev.RequestNonce,
+			Uri:            ev.Uri,
+			Fee:            ev.Fee,
+			ExtraData:      ev.ExtraData,
+			Raw:            ev.Raw,
+		},
+		Amount: ev.Amount,
+	}
 }
 
 func (rEv RequestValueTransferEvent) Nonce() uint64 {
@@ -104,6 +127,8 @@ type BridgeInfo struct {
 
 	newEvent chan struct{}
 	closed   chan struct{}
+
+	vtStatusMu sync.Mutex // to serialize the updates of the value transfer status records.
 }
 
 func NewBridgeInfo(db database.DBManager, addr common.Address, bridge *bridgecontract.Bridge, cpAddr common.Address, cpBridge *bridgecontract.Bridge, account *accountInfo, local, subscribed bool) (*BridgeInfo, error) {
@@ -125,6 +150,7 @@ func NewBridgeInfo(db database.DBManager, addr common.Address, bridge *bridgecon
 		0,
 		make(chan struct{}),
 		make(chan struct{}),
+		sync.Mutex{},
 	}
 
 	if err := bi.UpdateInfo(); err != nil {
@@ -225,6 +251,7 @@ func (bi *BridgeInfo) processingPendingRequestEvents() error {
 		}
 
 		if err := bi.handleRequestValueTransferEvent(ev); err != nil {
+			bi.markFailedValueTransfer(ev, err)
 			bi.AddRequestValueTransferEvents(ReadyEvent[idx:])
 			logger.Error("Failed handle request value transfer event", "err", err, "len(RePutEvent)", len(ReadyEvent[idx:]))
 			return err
@@ -269,7 +296,7 @@ func (bi *BridgeInfo) handleRequestValueTransferEvent(ev *RequestValueTransferEv
 	tokenType := ev.TokenType
 	tokenAddr := bi.GetCounterPartToken(ev.TokenAddress)
 	// TODO-Klaytn-Servicechain Add counterpart token address in requestValueTransferEvent
-	if tokenType != KLAY && tokenAddr == (common.Address{}) {
+	if tokenType != KLAY && tokenType != MESSAGE && tokenAddr == (common.Address{}) {
 		logger.Warn("Unregistered counter part token address.", "addr", tokenAddr.Hex())
 		ctTokenAddr, err := bi.counterpartBridge.AllowedTokens(nil, ev.TokenAddress)
 		if err != nil {
@@ -316,6 +343,19 @@ func (bi *BridgeInfo) handleRequestValueTransferEvent(ev *RequestValueTransferEv
 			return err
 		}
 		logger.Trace("Bridge succeeded to HandleERC721Transfer", "nonce", ev.RequestNonce, "tx", handleTx.Hash().String())
+	case ERC1155:
+		handleTx, err = bi.bridge.HandleERC1155Transfer(auth, ev.Raw.TxHash, ev.From, ev.To, tokenAddr, ev.ValueOrTokenId, ev.Amount, ev.RequestNonce, ev.Raw.BlockNumber, ev.Uri, ev.ExtraData)
+		if err != nil {
+			return err
+		}
+		logger.Trace("Bridge succeeded to HandleERC1155Transfer", "nonce", ev.RequestNonce, "tx", handleTx.Hash().String())
+	case MESSAGE:
+		// The gas limit of the message is requested in ValueOrTokenId.
+		handleTx, err = bi.bridge.HandleMessageTransfer(auth, ev.Raw.TxHash, ev.From, ev.To, ev.ValueOrTokenId.Uint64(), ev.RequestNonce, ev.Raw.BlockNumber, ev.ExtraData)
+		if err != nil {
+			return err
+		}
+		logger.Trace("Bridge succeeded to HandleMessageTransfer", "nonce", ev.RequestNonce, "tx", handleTx.Hash().String())
 	default:
 		logger.Warn("Got Unknown Token Type ReceivedEvent", "bridge", ev.Raw.Address, "nonce", ev.RequestNonce, "from", ev.From)
 		return nil
@@ -324,6 +364,7 @@ func (bi *BridgeInfo) handleRequestValueTransferEvent(ev *RequestValueTransferEv
 	bridgeAcc.IncNonce()
 
 	bi.bridgeDB.WriteHandleTxHashFromRequestTxHash(ev.Raw.TxHash, handleTx.Hash())
+	bi.markVotedValueTransfer(ev, handleTx.Hash())
 	return nil
 }
 
@@ -401,10 +442,11 @@ func (b *BridgeJournal) EncodeRLP(w io.Writer) error {
 type BridgeManager struct {
 	subBridge *SubBridge
 
-	receivedEvents map[common.Address]event.Subscription
-	withdrawEvents map[common.Address]event.Subscription
-	bridges        map[common.Address]*BridgeInfo
-	mu             sync.RWMutex
+	receivedEvents        map[common.Address]event.Subscription
+	receivedERC1155Events map[common.Address]event.Subscription
+	withdrawEvents        map[common.Address]event.Subscription
+	bridges               map[common.Address]*BridgeInfo
+	mu                    sync.RWMutex
 
 	requestEventFeeder event.Feed
 	handleEventFeeder  event.Feed
@@ -420,12 +462,13 @@ func NewBridgeManager(main *SubBridge) (*BridgeManager, error) {
 	bridgeAddrJournal := newBridgeAddrJournal(path.Join(main.config.DataDir, BridgeAddrJournal))
 
 	bridgeManager := &BridgeManager{
-		subBridge:      main,
-		receivedEvents: make(map[common.Address]event.Subscription),
-		withdrawEvents: make(map[common.Address]event.Subscription),
-		bridges:        make(map[common.Address]*BridgeInfo),
-		journal:        bridgeAddrJournal,
-		recoveries:     make(map[common.Address]*valueTransferRecovery),
+		subBridge:             main,
+		receivedEvents:        make(map[common.Address]event.Subscription),
+		receivedERC1155Events: make(map[common.Address]event.Subscription),
+		withdrawEvents:        make(map[common.Address]event.Subscription),
+		bridges:               make(map[common.Address]*BridgeInfo),
+		journal:               bridgeAddrJournal,
+		recoveries:            make(map[common.Address]*valueTransferRecovery),
 	}
 
 	logger.Info("Load Bridge Address from JournalFiles ", "path", bridgeManager.journal.path)
@@ -816,6 +859,7 @@ func (bm *BridgeManager) ResetAllSubscribedEvents() error {
 // SubscribeEvent sets watch logs and creates a goroutine loop to handle event messages.
 func (bm *BridgeManager) subscribeEvent(addr common.Address, bridge *bridgecontract.Bridge) error {
 	tokenReceivedCh := make(chan *bridgecontract.BridgeRequestValueTransfer, TokenEventChanSize)
+	erc1155ReceivedCh := make(chan *bridgecontract.BridgeRequestERC1155Transfer, TokenEventChanSize)
 	tokenWithdrawCh := make(chan *bridgecontract.BridgeHandleValueTransfer, TokenEventChanSize)
 
 	receivedSub, err := bridge.WatchRequestValueTransfer(nil, tokenReceivedCh)
@@ -824,25 +868,37 @@ func (bm *BridgeManager) subscribeEvent(addr common.Address, bridge *bridgecontr
 		return err
 	}
 	bm.receivedEvents[addr] = receivedSub
+	erc1155ReceivedSub, err := bridge.WatchRequestERC1155Transfer(nil, erc1155ReceivedCh)
+	if err != nil {
+		logger.Error("Failed to pBridge.WatchRequestERC1155Transfer", "err", err)
+		receivedSub.Unsubscribe()
+		delete(bm.receivedEvents, addr)
+		return err
+	}
+	bm.receivedERC1155Events[addr] = erc1155ReceivedSub
 	withdrawnSub, err := bridge.WatchHandleValueTransfer(nil, tokenWithdrawCh)
 	if err != nil {
 		logger.Error("Failed to pBridge.WatchTokenWithdrawn", "err", err)
 		receivedSub.Unsubscribe()
+		erc1155ReceivedSub.Unsubscribe()
 		delete(bm.receivedEvents, addr)
+		delete(bm.receivedERC1155Events, addr)
 		return err
 	}
 	bm.withdrawEvents[addr] = withdrawnSub
 	bridgeInfo, ok := bm.GetBridgeInfo(addr)
 	if !ok {
 		receivedSub.Unsubscribe()
+		erc1155ReceivedSub.Unsubscribe()
 		withdrawnSub.Unsubscribe()
 		delete(bm.receivedEvents, addr)
+		delete(bm.receivedERC1155Events, addr)
 		delete(bm.withdrawEvents, addr)
 		return ErrNoBridgeInfo
 	}
 	bridgeInfo.subscribed = true
 
-	go bm.loop(addr, tokenReceivedCh, tokenWithdrawCh, receivedSub, withdrawnSub)
+	go bm.loop(addr, tokenReceivedCh, erc1155ReceivedCh, tokenWithdrawCh, receivedSub, erc1155ReceivedSub, withdrawnSub)
 
 	return nil
 }
@@ -855,6 +911,12 @@ func (bm *BridgeManager) UnsubscribeEvent(addr common.Address) {
 		delete(bm.receivedEvents, addr)
 	}
 
+	erc1155ReceivedSub := bm.receivedERC1155Events[addr]
+	if erc1155ReceivedSub != nil {
+		erc1155ReceivedSub.Unsubscribe()
+		delete(bm.receivedERC1155Events, addr)
+	}
+
 	withdrawSub := bm.withdrawEvents[addr]
 	if withdrawSub != nil {
 		withdrawSub.Unsubscribe()
@@ -871,11 +933,14 @@ func (bm *BridgeManager) UnsubscribeEvent(addr common.Address) {
 func (bm *BridgeManager) loop(
 	addr common.Address,
 	requestEventCh <-chan *bridgecontract.BridgeRequestValueTransfer,
+	erc1155RequestEventCh <-chan *bridgecontract.BridgeRequestERC1155Transfer,
 	handleEventCh <-chan *bridgecontract.BridgeHandleValueTransfer,
 	requestEventSub event.Subscription,
+	erc1155RequestEventSub event.Subscription,
 	handleEventSub event.Subscription) {
 
 	defer requestEventSub.Unsubscribe()
+	defer erc1155RequestEventSub.Unsubscribe()
 	defer handleEventSub.Unsubscribe()
 
 	bi, ok := bm.GetBridgeInfo(addr)
@@ -890,12 +955,17 @@ func (bm *BridgeManager) loop(
 		case <-bi.closed:
 			return
 		case ev := <-requestEventCh:
-			bm.requestEventFeeder.Send(&RequestValueTransferEvent{ev})
+			bm.requestEventFeeder.Send(&RequestValueTransferEvent{BridgeRequestValueTransfer: ev})
+		case ev := <-erc1155RequestEventCh:
+			bm.requestEventFeeder.Send(newRequestERC1155TransferEvent(ev))
 		case ev := <-handleEventCh:
 			bm.handleEventFeeder.Send(&HandleValueTransferEvent{ev})
 		case err := <-requestEventSub.Err():
 			logger.Info("Contract Event Loop Running Stop by receivedSub.Err()", "err", err)
 			return
+		case err := <-erc1155RequestEventSub.Err():
+			logger.Info("Contract Event Loop Running Stop by erc1155ReceivedSub.Err()", "err", err)
+			return
 		case err := <-handleEventSub.Err():
 			logger.Info("Contract Event Loop Running Stop by withdrawSub.Err()", "err", err)
 			return
@@ -941,6 +1011,32 @@ func (bm *BridgeManager) SetERC20Fee(bridgeAddr, tokenAddr common.Address, fee *
 	return tx.Hash(), nil
 }
 
+// SetERC1155Fee set the ERC1155 transfer fee on the bridge contract.
+func (bm *BridgeManager) SetERC1155Fee(bridgeAddr, tokenAddr common.Address, fee *big.Int) (common.Hash, error) {
+	bi, ok := bm.GetBridgeInfo(bridgeAddr)
+	if !ok {
+		return common.Hash{}, ErrNoBridgeInfo
+	}
+
+	auth := bi.account
+	auth.Lock()
+	defer auth.UnLock()
+
+	rn, err := bi.bridge.ConfigurationNonce(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := bi.bridge.SetERC1155Fee(auth.GetTransactOpts(), tokenAddr, fee, rn)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	auth.IncNonce()
+
+	return tx.Hash(), nil
+}
+
 // SetKLAYFee set the KLAY transfer fee on the bridge contract.
 func (bm *BridgeManager) SetKLAYFee(bridgeAddr common.Address, fee *big.Int) (common.Hash, error) {
 	bi, ok := bm.GetBridgeInfo(bridgeAddr)
@@ -998,6 +1094,16 @@ func (bm *BridgeManager) GetERC20Fee(bridgeAddr, tokenAddr common.Address) (*big
 	return bi.bridge.FeeOfERC20(nil, tokenAddr)
 }
 
+// GetERC1155Fee returns the ERC1155 transfer fee on the bridge contract.
+func (bm *BridgeManager) GetERC1155Fee(bridgeAddr, tokenAddr common.Address) (*big.Int, error) {
+	bi, ok := bm.GetBridgeInfo(bridgeAddr)
+	if !ok {
+		return nil, ErrNoBridgeInfo
+	}
+
+	return bi.bridge.FeeOfERC1155(nil, tokenAddr)
+}
+
 // GetKLAYFee returns the KLAY transfer fee on the bridge contract.
 func (bm *BridgeManager) GetKLAYFee(bridgeAddr common.Address) (*big.Int, error) {
 	bi, ok := bm.GetBridgeInfo(bridgeAddr)
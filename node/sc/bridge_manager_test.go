@@ -19,6 +19,7 @@ package sc
 import (
 	"context"
 	"fmt"
+	"github.com/klaytn/klaytn/accounts/abi"
 	"github.com/klaytn/klaytn/accounts/abi/bind"
 	"github.com/klaytn/klaytn/accounts/abi/bind/backends"
 	"github.com/klaytn/klaytn/blockchain"
@@ -38,6 +39,7 @@ import (
 	"math/big"
 	"os"
 	"path"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -1256,6 +1258,119 @@ func TestErrorDupSubscription(t *testing.T) {
 	bm.Stop()
 }
 
+// TestHandleMessageTransferEvent checks if a message transfer request is handled
+// without looking up the counterpart token, and the message is delivered to the
+// target contract with the requested gas limit.
+func TestHandleMessageTransferEvent(t *testing.T) {
+	skipIfNotCompiled(t, bridge.BridgeBinRuntime, "65ccb3ff", "c257dd6e")
+
+	tempDir, err := ioutil.TempDir(os.TempDir(), "sc")
+	assert.NoError(t, err)
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			t.Fatalf("fail to delete file %v", err)
+		}
+	}()
+
+	config := &SCConfig{}
+	config.DataDir = tempDir
+
+	bacc, _ := NewBridgeAccounts(tempDir)
+	bacc.pAccount.chainID = big.NewInt(0)
+	bacc.cAccount.chainID = big.NewInt(0)
+
+	aliceKey, _ := crypto.GenerateKey()
+	aliceAuth := bind.NewKeyedTransactor(aliceKey)
+
+	alloc := blockchain.GenesisAlloc{
+		bacc.pAccount.address: {Balance: big.NewInt(params.KLAY)},
+		bacc.cAccount.address: {Balance: big.NewInt(params.KLAY)},
+		aliceAuth.From:        {Balance: big.NewInt(params.KLAY)},
+	}
+	sim := backends.NewSimulatedBackend(alloc)
+
+	sc := &SubBridge{
+		chainDB:        database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB}),
+		config:         config,
+		peers:          newBridgePeerSet(),
+		localBackend:   sim,
+		remoteBackend:  sim,
+		bridgeAccounts: bacc,
+	}
+
+	bm, err := NewBridgeManager(sc)
+	assert.NoError(t, err)
+	defer bm.Stop()
+
+	addr, err := bm.DeployBridgeTest(sim, false)
+	assert.NoError(t, err)
+	sim.Commit()
+
+	// The receiver stores the size of its call data in the first storage slot.
+	receiverABI, err := abi.JSON(strings.NewReader(bridge.IBridgeMessageReceiverABI))
+	assert.NoError(t, err)
+	receiverCode := common.FromHex("0x600580600b6000396000f3" + "3660005500")
+	receiverAddr, tx, _, err := bind.DeployContract(aliceAuth, receiverABI, receiverCode, sim)
+	assert.NoError(t, err)
+	sim.Commit()
+	assert.NoError(t, bind.CheckWaitMined(sim, tx))
+
+	// The counterpart bridge is not set, so any counterpart token lookup fails.
+	bridgeInfo, ok := bm.GetBridgeInfo(addr)
+	assert.True(t, ok)
+
+	const gasLimit = 100000
+	from := common.HexToAddress("0x2")
+	message := []byte("message")
+	requestTxHash := common.HexToHash("0x1")
+	ev := &RequestValueTransferEvent{
+		BridgeRequestValueTransfer: &bridge.BridgeRequestValueTransfer{
+			TokenType:      MESSAGE,
+			From:           from,
+			To:             receiverAddr,
+			ValueOrTokenId: big.NewInt(gasLimit),
+			RequestNonce:   0,
+			Fee:            big.NewInt(0),
+			ExtraData:      message,
+			Raw:            types.Log{TxHash: requestTxHash, BlockNumber: 1},
+		},
+	}
+	assert.NoError(t, bridgeInfo.handleRequestValueTransferEvent(ev))
+	assert.Equal(t, common.Address{}, bridgeInfo.GetCounterPartToken(common.Address{}))
+	handleTxHash := sc.chainDB.ReadHandleTxHashFromRequestTxHash(requestTxHash)
+	assert.NotEqual(t, common.Hash{}, handleTxHash)
+	sim.Commit()
+
+	receipt, err := sim.TransactionReceipt(context.Background(), handleTxHash)
+	if assert.NoError(t, err) {
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	}
+
+	handleIt, err := bridgeInfo.bridge.FilterHandleValueTransfer(&bind.FilterOpts{Start: 0})
+	assert.NoError(t, err)
+	if assert.True(t, handleIt.Next()) {
+		assert.Equal(t, [32]byte(requestTxHash), handleIt.Event.RequestTxHash)
+		assert.Equal(t, MESSAGE, handleIt.Event.TokenType)
+		assert.Equal(t, from, handleIt.Event.From)
+		assert.Equal(t, receiverAddr, handleIt.Event.To)
+		assert.Equal(t, big.NewInt(gasLimit), handleIt.Event.ValueOrTokenId)
+		assert.Equal(t, message, handleIt.Event.ExtraData)
+	}
+	assert.False(t, handleIt.Next())
+	assert.NoError(t, handleIt.Close())
+
+	failedIt, err := bridgeInfo.bridge.FilterMessageTransferFailed(&bind.FilterOpts{Start: 0})
+	assert.NoError(t, err)
+	assert.False(t, failedIt.Next())
+	assert.NoError(t, failedIt.Close())
+
+	input, err := receiverABI.Pack("onBridgeMessageReceived", from, message)
+	assert.NoError(t, err)
+	stored, err := sim.StorageAt(context.Background(), receiverAddr, common.Hash{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(int64(len(input)))).Bytes(), stored)
+}
+
 // TestAnchoringBasic tests the following:
 // 1. generate anchoring tx
 // 2. decode anchoring tx
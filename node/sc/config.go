@@ -88,12 +88,18 @@ type SCConfig struct {
 	// ServiceChain
 	ServiceChainConsensus string
 	AnchoringPeriod       uint64
+	AnchoringBatch        bool
 	SentChainTxsLimit     uint64
 
 	ParentChainID      uint64
 	VTRecovery         bool
 	VTRecoveryInterval uint64
 	Anchoring          bool
+
+	// External signers of the bridge operator accounts. If one is set, the first account
+	// of the signer is used as the operator instead of the one in the data directory.
+	ParentOperatorSigner string `toml:",omitempty"`
+	ChildOperatorSigner  string `toml:",omitempty"`
 }
 
 // NodeName returns the devp2p node identifier.
@@ -26,10 +26,13 @@ func (s SCConfig) MarshalTOML() (interface{}, error) {
 		MaxPeer               int
 		ServiceChainConsensus string
 		AnchoringPeriod       uint64
+		AnchoringBatch        bool
 		SentChainTxsLimit     uint64
 		ParentChainID         uint64
 		VTRecovery            bool
 		VTRecoveryInterval    uint64
+		ParentOperatorSigner  string `toml:",omitempty"`
+		ChildOperatorSigner   string `toml:",omitempty"`
 	}
 	var enc SCConfig
 	enc.Name = s.Name
@@ -49,10 +52,13 @@ func (s SCConfig) MarshalTOML() (interface{}, error) {
 	enc.MaxPeer = s.MaxPeer
 	enc.ServiceChainConsensus = s.ServiceChainConsensus
 	enc.AnchoringPeriod = s.AnchoringPeriod
+	enc.AnchoringBatch = s.AnchoringBatch
 	enc.SentChainTxsLimit = s.SentChainTxsLimit
 	enc.ParentChainID = s.ParentChainID
 	enc.VTRecovery = s.VTRecovery
 	enc.VTRecoveryInterval = s.VTRecoveryInterval
+	enc.ParentOperatorSigner = s.ParentOperatorSigner
+	enc.ChildOperatorSigner = s.ChildOperatorSigner
 	return &enc, nil
 }
 
@@ -76,10 +82,13 @@ func (s *SCConfig) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		MaxPeer               *int
 		ServiceChainConsensus *string
 		AnchoringPeriod       *uint64
+		AnchoringBatch        *bool
 		SentChainTxsLimit     *uint64
 		ParentChainID         *uint64
 		VTRecovery            *bool
 		VTRecoveryInterval    *uint64
+		ParentOperatorSigner  *string `toml:",omitempty"`
+		ChildOperatorSigner   *string `toml:",omitempty"`
 	}
 	var dec SCConfig
 	if err := unmarshal(&dec); err != nil {
@@ -136,6 +145,9 @@ func (s *SCConfig) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.AnchoringPeriod != nil {
 		s.AnchoringPeriod = *dec.AnchoringPeriod
 	}
+	if dec.AnchoringBatch != nil {
+		s.AnchoringBatch = *dec.AnchoringBatch
+	}
 	if dec.SentChainTxsLimit != nil {
 		s.SentChainTxsLimit = *dec.SentChainTxsLimit
 	}
@@ -148,5 +160,11 @@ func (s *SCConfig) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.VTRecoveryInterval != nil {
 		s.VTRecoveryInterval = *dec.VTRecoveryInterval
 	}
+	if dec.ParentOperatorSigner != nil {
+		s.ParentOperatorSigner = *dec.ParentOperatorSigner
+	}
+	if dec.ChildOperatorSigner != nil {
+		s.ChildOperatorSigner = *dec.ChildOperatorSigner
+	}
 	return nil
 }
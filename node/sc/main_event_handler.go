@@ -20,7 +20,6 @@ import (
 	"errors"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
-	"github.com/klaytn/klaytn/ser/rlp"
 )
 
 var (
@@ -89,30 +88,13 @@ func (mce *MainChainEventHandler) decodeAndWriteChildChainTxHash(tx *types.Trans
 		logger.Error("writeChildChainTxHashFromBlock : failed to get anchoring data from the tx", "txHash", tx.Hash().String())
 		return
 	}
-	anchoringData := new(types.AnchoringData)
-	if err := rlp.DecodeBytes(data, anchoringData); err != nil {
-		// Try to decode old type without a type support for compatibility.
-		anchoringDataLegacy := new(types.AnchoringDataLegacy)
-		if err := rlp.DecodeBytes(data, anchoringDataLegacy); err != nil {
-			logger.Error("writeChildChainTxHashFromBlock : failed to decode anchoring data", "txHash", tx.Hash().String())
-			return
-		}
-		mce.mainbridge.chainDB.WriteChildChainTxHash(anchoringDataLegacy.BlockHash, tx.Hash())
-		logger.Trace("Write legacy anchoring data on chainDB", "blockHash", anchoringDataLegacy.BlockHash.String(), "txHash", tx.Hash().String())
-		return
-	}
-	if anchoringData.Type == types.AnchoringDataType0 {
-		anchoringDataInternal := new(types.AnchoringDataInternalType0)
-		if err := rlp.DecodeBytes(anchoringData.Data, anchoringDataInternal); err != nil {
-			logger.Error("writeChildChainTxHashFromBlock : failed to decode anchoring data", "txHash", tx.Hash().String())
-			return
-		}
-		mce.mainbridge.chainDB.WriteChildChainTxHash(anchoringDataInternal.BlockHash, tx.Hash())
-		logger.Trace("Write type0 anchoring data on chainDB", "blockHash", anchoringDataInternal.BlockHash.String(), "txHash", tx.Hash().String())
-	} else {
-		logger.Error("writeChildChainTxHashFromBlock : failed to decode anchoring data. unknown type", "type", anchoringData.Type, "txHash", tx.Hash().String())
+	anchoringData, err := types.DecodeAnchoringData(data)
+	if err != nil {
+		logger.Error("writeChildChainTxHashFromBlock : failed to decode anchoring data", "txHash", tx.Hash().String(), "err", err)
 		return
 	}
+	mce.mainbridge.chainDB.WriteChildChainTxHash(anchoringData.GetBlockHash(), tx.Hash())
+	logger.Trace("Write anchoring data on chainDB", "blockHash", anchoringData.GetBlockHash().String(), "txHash", tx.Hash().String())
 }
 
 // TODO-Klaytn-ServiceChain: remove this method and a related option.
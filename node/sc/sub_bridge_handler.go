@@ -17,7 +17,6 @@
 package sc
 
 import (
-	"errors"
 	"fmt"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -31,10 +30,6 @@ const (
 	SyncRequestInterval = 10
 )
 
-var (
-	errUnknownAnchoringTxType = errors.New("unknown anchoring tx type")
-)
-
 // parentChainInfo handles the information of parent chain, which is needed from child chain.
 type parentChainInfo struct {
 	Nonce    uint64
@@ -52,6 +47,7 @@ type SubBridgeHandler struct {
 	mainChainAccountNonce uint64
 	nonceSynced           bool
 	chainTxPeriod         uint64
+	chainTxBatch          bool // anchoring all blocks of each period in one anchoring tx.
 
 	latestTxCountAddedBlockNumber uint64
 	txCountEnabledBlockNumber     uint64
@@ -71,6 +67,7 @@ func NewSubBridgeHandler(main *SubBridge) (*SubBridgeHandler, error) {
 		mainChainAccountNonce:         uint64(0),
 		nonceSynced:                   false,
 		chainTxPeriod:                 main.config.AnchoringPeriod,
+		chainTxBatch:                  main.config.AnchoringBatch,
 		latestTxCountAddedBlockNumber: uint64(0),
 		sentServiceChainTxsLimit:      main.config.SentChainTxsLimit,
 	}, nil
@@ -247,7 +244,13 @@ func (sbh *SubBridgeHandler) handleParentChainReceiptResponseMsg(p BridgePeer, m
 // genUnsignedChainDataAnchoringTx generates an unsigned transaction, which type is TxTypeChainDataAnchoring.
 // Nonce of account used for service chain transaction will be increased after the signing.
 func (sbh *SubBridgeHandler) genUnsignedChainDataAnchoringTx(block *types.Block) (*types.Transaction, error) {
-	anchoringData, err := types.NewAnchoringDataType0(block, new(big.Int).SetUint64(sbh.chainTxPeriod), new(big.Int).SetUint64(sbh.txCount))
+	var anchoringData *types.AnchoringData
+	var err error
+	if sbh.chainTxBatch {
+		anchoringData, err = types.NewAnchoringDataType1(sbh.getAnchoringBatchHeaders(block), new(big.Int).SetUint64(sbh.txCount))
+	} else {
+		anchoringData, err = types.NewAnchoringDataType0(block, new(big.Int).SetUint64(sbh.chainTxPeriod), new(big.Int).SetUint64(sbh.txCount))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +274,30 @@ func (sbh *SubBridgeHandler) genUnsignedChainDataAnchoringTx(block *types.Block)
 	}
 }
 
+// getAnchoringBatchHeaders returns the headers of the anchoring period which ends with the given block.
+func (sbh *SubBridgeHandler) getAnchoringBatchHeaders(block *types.Block) []*types.Header {
+	return getAnchoringBatchHeaders(sbh.subbridge.blockchain, block.Header(), sbh.chainTxPeriod)
+}
+
+// getAnchoringBatchHeaders returns the headers from the first block of the anchoring period to the last block.
+// The period begins after the previous block whose number is a multiple of the period.
+func getAnchoringBatchHeaders(bc headerReader, last *types.Header, period uint64) []*types.Header {
+	headers := []*types.Header{last}
+	for i := uint64(1); i < period && headers[0].Number.Uint64() > 1; i++ {
+		parent := bc.GetHeaderByHash(headers[0].ParentHash)
+		if parent == nil {
+			break
+		}
+		headers = append([]*types.Header{parent}, headers...)
+	}
+	return headers
+}
+
+// headerReader reads the headers of the chain.
+type headerReader interface {
+	GetHeaderByHash(hash common.Hash) *types.Header
+}
+
 // LocalChainHeadEvent deals with servicechain feature to generate/broadcast service chain transactions and request receipts.
 func (sbh *SubBridgeHandler) LocalChainHeadEvent(block *types.Block) {
 	if sbh.getParentOperatorNonceSynced() {
@@ -317,25 +344,12 @@ func (sbh *SubBridgeHandler) broadcastServiceChainTx() {
 
 // decodeAnchoringTx decodes an anchoring transaction.
 func (sbh *SubBridgeHandler) decodeAnchoringTx(data []byte) (common.Hash, *big.Int, error) {
-	anchoringData := new(types.AnchoringData)
-	if err := rlp.DecodeBytes(data, anchoringData); err != nil {
-		anchoringDataLegacy := new(types.AnchoringDataLegacy)
-		if err := rlp.DecodeBytes(data, anchoringDataLegacy); err != nil {
-			return common.Hash{}, nil, err
-		}
-		logger.Trace("decoded legacy anchoring tx", "blockNum", anchoringDataLegacy.BlockNumber.String(), "blockHash", anchoringDataLegacy.BlockHash.String(), "txHash", anchoringDataLegacy.TxHash.String())
-		return anchoringDataLegacy.BlockHash, anchoringDataLegacy.BlockNumber, nil
-	}
-	if anchoringData.Type == types.AnchoringDataType0 {
-		anchoringDataInternal := new(types.AnchoringDataInternalType0)
-		if err := rlp.DecodeBytes(anchoringData.Data, anchoringDataInternal); err != nil {
-			return common.Hash{}, nil, err
-		}
-		logger.Trace("decoded type0 anchoring tx", "blockNum", anchoringDataInternal.BlockNumber.String(), "blockHash", anchoringDataInternal.BlockHash.String(), "txHash", anchoringDataInternal.TxHash.String(), "txCount", anchoringDataInternal.TxCount)
-		return anchoringDataInternal.BlockHash, anchoringDataInternal.BlockNumber, nil
-	} else {
-		return common.Hash{}, nil, errUnknownAnchoringTxType
+	anchoringData, err := types.DecodeAnchoringData(data)
+	if err != nil {
+		return common.Hash{}, nil, err
 	}
+	logger.Trace("decoded anchoring tx", "blockNum", anchoringData.GetBlockNumber().String(), "blockHash", anchoringData.GetBlockHash().String())
+	return anchoringData.GetBlockHash(), anchoringData.GetBlockNumber(), nil
 }
 
 // writeServiceChainTxReceipts writes the received receipts of service chain transactions.
@@ -78,6 +78,8 @@ func (cce *ChildChainEventHandler) ProcessRequestEvent(ev *RequestValueTransferE
 		return fmt.Errorf("there is no counter part bridge info(%v) of the bridge(%v)", handleBridgeAddr.String(), ev.Raw.Address.String())
 	}
 
+	handleBridgeInfo.markRequestedValueTransfer(ev)
+
 	// TODO-Klaytn need to manage the size limitation of pending event list.
 	handleBridgeInfo.AddRequestValueTransferEvents([]*RequestValueTransferEvent{ev})
 	return nil
@@ -90,6 +92,7 @@ func (cce *ChildChainEventHandler) ProcessHandleEvent(ev *HandleValueTransferEve
 	}
 
 	handleBridgeInfo.UpdateHandledNonce(ev.HandleNonce + 1)
+	handleBridgeInfo.markHandledValueTransfer(ev)
 
 	logger.Trace("RequestValueTransfer Event",
 		"bridgeAddr", ev.Raw.Address.String(),
@@ -193,7 +193,7 @@ func NewSubBridge(ctx *node.ServiceContext, config *SCConfig) (*SubBridge, error
 	sc.bridgeTxPool = bridgepool.NewBridgeTxPool(bridgetxConfig)
 
 	var err error
-	sc.bridgeAccounts, err = NewBridgeAccounts(config.DataDir)
+	sc.bridgeAccounts, err = NewBridgeAccountsWithSigners(config.DataDir, config.ParentOperatorSigner, config.ChildOperatorSigner)
 	if err != nil {
 		return nil, err
 	}
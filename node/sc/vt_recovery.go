@@ -260,7 +260,19 @@ func retrievePendingEventsFrom(hint *valueTransferHint, br *bridge.Bridge) ([]*R
 		logger.Trace("pending nonce in the event", "requestNonce", it.Event.RequestNonce)
 		if it.Event.RequestNonce >= hint.handleNonce {
 			logger.Trace("filtered pending nonce", "requestNonce", it.Event.RequestNonce, "handledNonce", hint.handleNonce)
-			pendingEvents = append(pendingEvents, &RequestValueTransferEvent{it.Event})
+			pendingEvents = append(pendingEvents, &RequestValueTransferEvent{BridgeRequestValueTransfer: it.Event})
+		}
+	}
+
+	erc1155It, err := br.FilterRequestERC1155Transfer(&bind.FilterOpts{Start: hint.blockNumber}) // to the current
+	if err != nil {
+		return nil, err
+	}
+	for erc1155It.Next() {
+		logger.Trace("pending nonce in the ERC1155 event", "requestNonce", erc1155It.Event.RequestNonce)
+		if erc1155It.Event.RequestNonce >= hint.handleNonce {
+			logger.Trace("filtered pending nonce", "requestNonce", erc1155It.Event.RequestNonce, "handledNonce", hint.handleNonce)
+			pendingEvents = append(pendingEvents, newRequestERC1155TransferEvent(erc1155It.Event))
 		}
 	}
 	logger.Debug("retrieved pending events", "len(pendingEvents)", len(pendingEvents))
@@ -0,0 +1,191 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
+	"math/big"
+)
+
+// The statuses of a value transfer.
+const (
+	VTStatusRequested = "requested" // the request event is received from the counterpart bridge.
+	VTStatusVoted     = "voted"     // the bridge operator sent the handle transaction.
+	VTStatusHandled   = "handled"   // the handle event is emitted after the operators reached the threshold.
+	VTStatusFailed    = "failed"    // the bridge operator failed to send the handle transaction. It will be retried.
+)
+
+// maxValueTransferStatusScan is the maximum number of the latest value transfers
+// which are looked up by the status queries.
+const maxValueTransferStatusScan = 10000
+
+// ValueTransferStatus is the persistent record of a value transfer request.
+// It is stored in the bridge service DB with the request bridge address and the request nonce.
+type ValueTransferStatus struct {
+	Status             string         `json:"status"`
+	RequestBridge      common.Address `json:"requestBridge"`
+	RequestNonce       uint64         `json:"requestNonce"`
+	RequestTxHash      common.Hash    `json:"requestTxHash"`
+	RequestBlockNumber uint64         `json:"requestBlockNumber"`
+	TokenType          uint8          `json:"tokenType"`
+	From               common.Address `json:"from"`
+	To                 common.Address `json:"to"`
+	TokenAddress       common.Address `json:"tokenAddress"`
+	ValueOrTokenId     *big.Int       `json:"valueOrTokenId"`
+	Amount             *big.Int       `json:"amount"` // the amount of ERC1155 tokens.
+	Fee                *big.Int       `json:"fee"`
+	HandleTxHash       common.Hash    `json:"handleTxHash"`
+	Retries            uint64         `json:"retries"`
+	Error              string         `json:"error"` // the last error of sending the handle transaction.
+}
+
+// newValueTransferStatus returns a status record of the given request event with VTStatusRequested.
+func newValueTransferStatus(ev *RequestValueTransferEvent) *ValueTransferStatus {
+	amount := ev.Amount
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+	return &ValueTransferStatus{
+		Status:             VTStatusRequested,
+		RequestBridge:      ev.Raw.Address,
+		RequestNonce:       ev.RequestNonce,
+		RequestTxHash:      ev.Raw.TxHash,
+		RequestBlockNumber: ev.Raw.BlockNumber,
+		TokenType:          ev.TokenType,
+		From:               ev.From,
+		To:                 ev.To,
+		TokenAddress:       ev.TokenAddress,
+		ValueOrTokenId:     ev.ValueOrTokenId,
+		Amount:             amount,
+		Fee:                ev.Fee,
+	}
+}
+
+// ReadValueTransferStatus returns the status record of the value transfer requested
+// to the given bridge with the given request nonce. It returns nil if there is no record.
+func ReadValueTransferStatus(db database.DBManager, bridgeAddr common.Address, requestNonce uint64) *ValueTransferStatus {
+	data := db.ReadValueTransferStatus(bridgeAddr, requestNonce)
+	if data == nil {
+		return nil
+	}
+	status := new(ValueTransferStatus)
+	if err := rlp.DecodeBytes(data, status); err != nil {
+		logger.Error("Invalid value transfer status RLP", "bridge", bridgeAddr.String(), "requestNonce", requestNonce, "err", err)
+		return nil
+	}
+	return status
+}
+
+// WriteValueTransferStatus stores the given status record of the value transfer.
+func WriteValueTransferStatus(db database.DBManager, status *ValueTransferStatus) {
+	data, err := rlp.EncodeToBytes(status)
+	if err != nil {
+		logger.Error("Failed to RLP encode value transfer status", "bridge", status.RequestBridge.String(), "requestNonce", status.RequestNonce, "err", err)
+		return
+	}
+	db.WriteValueTransferStatus(status.RequestBridge, status.RequestNonce, data)
+}
+
+// updateValueTransferStatus applies update to the status record of the given request event.
+// A new record is made from the event if there is no record yet.
+func (bi *BridgeInfo) updateValueTransferStatus(ev *RequestValueTransferEvent, update func(status *ValueTransferStatus)) {
+	bi.vtStatusMu.Lock()
+	defer bi.vtStatusMu.Unlock()
+
+	status := ReadValueTransferStatus(bi.bridgeDB, ev.Raw.Address, ev.RequestNonce)
+	if status == nil {
+		status = newValueTransferStatus(ev)
+	}
+	update(status)
+	WriteValueTransferStatus(bi.bridgeDB, status)
+}
+
+// markRequestedValueTransfer records the given request event which will be handled by the bridge.
+func (bi *BridgeInfo) markRequestedValueTransfer(ev *RequestValueTransferEvent) {
+	bi.updateValueTransferStatus(ev, func(status *ValueTransferStatus) {})
+}
+
+// markVotedValueTransfer records that the bridge operator sent the handle transaction of the request event.
+func (bi *BridgeInfo) markVotedValueTransfer(ev *RequestValueTransferEvent, handleTxHash common.Hash) {
+	bi.updateValueTransferStatus(ev, func(status *ValueTransferStatus) {
+		if status.Status == VTStatusHandled {
+			return
+		}
+		if status.Status != VTStatusRequested {
+			status.Retries++
+		}
+		status.Status = VTStatusVoted
+		status.HandleTxHash = handleTxHash
+		status.Error = ""
+	})
+}
+
+// markFailedValueTransfer records that the bridge operator failed to handle the request event.
+func (bi *BridgeInfo) markFailedValueTransfer(ev *RequestValueTransferEvent, err error) {
+	bi.updateValueTransferStatus(ev, func(status *ValueTransferStatus) {
+		if status.Status == VTStatusHandled {
+			return
+		}
+		if status.Status != VTStatusRequested {
+			status.Retries++
+		}
+		status.Status = VTStatusFailed
+		status.Error = err.Error()
+	})
+}
+
+// markHandledValueTransfer records that the value transfer is handled by the bridge.
+// The request bridge of the handle event is the counterpart bridge.
+func (bi *BridgeInfo) markHandledValueTransfer(ev *HandleValueTransferEvent) {
+	bi.vtStatusMu.Lock()
+	defer bi.vtStatusMu.Unlock()
+
+	status := ReadValueTransferStatus(bi.bridgeDB, bi.counterpartAddress, ev.HandleNonce)
+	if status == nil {
+		status = &ValueTransferStatus{
+			RequestBridge:  bi.counterpartAddress,
+			RequestNonce:   ev.HandleNonce,
+			RequestTxHash:  ev.RequestTxHash,
+			TokenType:      ev.TokenType,
+			From:           ev.From,
+			To:             ev.To,
+			ValueOrTokenId: ev.ValueOrTokenId,
+			Amount:         big.NewInt(0),
+			Fee:            big.NewInt(0),
+		}
+	}
+	status.Status = VTStatusHandled
+	status.HandleTxHash = ev.Raw.TxHash
+	status.Error = ""
+	WriteValueTransferStatus(bi.bridgeDB, status)
+}
+
+// FindValueTransferStatuses returns the status records of the value transfers requested to
+// the counterpart bridge which satisfy the given condition, from the latest request.
+// Only the latest maxValueTransferStatusScan requests are looked up.
+func (bi *BridgeInfo) FindValueTransferStatuses(match func(status *ValueTransferStatus) bool) []*ValueTransferStatus {
+	statuses := []*ValueTransferStatus{}
+	for i := uint64(0); i < bi.requestNonceFromCounterPart && i < maxValueTransferStatusScan; i++ {
+		status := ReadValueTransferStatus(bi.bridgeDB, bi.counterpartAddress, bi.requestNonceFromCounterPart-1-i)
+		if status != nil && match(status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"errors"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/contracts/bridge"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func newTestRequestEvent(bridgeAddr, from common.Address, nonce uint64) *RequestValueTransferEvent {
+	return &RequestValueTransferEvent{
+		BridgeRequestValueTransfer: &bridge.BridgeRequestValueTransfer{
+			TokenType:      KLAY,
+			From:           from,
+			To:             common.HexToAddress("0x10"),
+			ValueOrTokenId: big.NewInt(100),
+			RequestNonce:   nonce,
+			Fee:            big.NewInt(10),
+			Raw:            types.Log{Address: bridgeAddr, TxHash: common.BigToHash(new(big.Int).SetUint64(nonce + 1))},
+		},
+	}
+}
+
+// TestValueTransferStatus checks the status transitions of a value transfer record.
+func TestValueTransferStatus(t *testing.T) {
+	db := database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB})
+	requestBridge := common.HexToAddress("0x1")
+	bi := &BridgeInfo{bridgeDB: db, counterpartAddress: requestBridge}
+
+	ev := newTestRequestEvent(requestBridge, common.HexToAddress("0x2"), 0)
+	assert.Nil(t, ReadValueTransferStatus(db, requestBridge, 0))
+
+	// 1. requested
+	bi.markRequestedValueTransfer(ev)
+	status := ReadValueTransferStatus(db, requestBridge, 0)
+	assert.Equal(t, VTStatusRequested, status.Status)
+	assert.Equal(t, ev.Raw.TxHash, status.RequestTxHash)
+	assert.Equal(t, big.NewInt(100), status.ValueOrTokenId)
+	assert.Equal(t, big.NewInt(10), status.Fee)
+
+	// 2. failed and retried
+	bi.markFailedValueTransfer(ev, errors.New("nonce too low"))
+	status = ReadValueTransferStatus(db, requestBridge, 0)
+	assert.Equal(t, VTStatusFailed, status.Status)
+	assert.Equal(t, "nonce too low", status.Error)
+	assert.Equal(t, uint64(0), status.Retries)
+
+	handleTxHash := common.HexToHash("0x100")
+	bi.markVotedValueTransfer(ev, handleTxHash)
+	status = ReadValueTransferStatus(db, requestBridge, 0)
+	assert.Equal(t, VTStatusVoted, status.Status)
+	assert.Equal(t, handleTxHash, status.HandleTxHash)
+	assert.Equal(t, uint64(1), status.Retries)
+	assert.Equal(t, "", status.Error)
+
+	// 3. handled
+	bi.markHandledValueTransfer(&HandleValueTransferEvent{
+		&bridge.BridgeHandleValueTransfer{
+			RequestTxHash:  ev.Raw.TxHash,
+			HandleNonce:    0,
+			ValueOrTokenId: big.NewInt(100),
+			Raw:            types.Log{TxHash: handleTxHash},
+		},
+	})
+	status = ReadValueTransferStatus(db, requestBridge, 0)
+	assert.Equal(t, VTStatusHandled, status.Status)
+	assert.Equal(t, ev.From, status.From)
+
+	// The handled status is not overwritten by a late failure.
+	bi.markFailedValueTransfer(ev, errors.New("already handled"))
+	assert.Equal(t, VTStatusHandled, ReadValueTransferStatus(db, requestBridge, 0).Status)
+}
+
+// TestFindValueTransferStatuses checks if the status records are looked up from the latest request.
+func TestFindValueTransferStatuses(t *testing.T) {
+	db := database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB})
+	requestBridge := common.HexToAddress("0x1")
+	alice := common.HexToAddress("0x2")
+	bob := common.HexToAddress("0x3")
+	bi := &BridgeInfo{bridgeDB: db, counterpartAddress: requestBridge}
+
+	for nonce := uint64(0); nonce < 5; nonce++ {
+		sender := alice
+		if nonce%2 == 1 {
+			sender = bob
+		}
+		ev := newTestRequestEvent(requestBridge, sender, nonce)
+		bi.UpdateRequestNonceFromCounterpart(nonce + 1)
+		bi.markRequestedValueTransfer(ev)
+		if nonce < 2 {
+			bi.markVotedValueTransfer(ev, common.HexToHash("0x100"))
+		}
+	}
+
+	statuses := bi.FindValueTransferStatuses(func(status *ValueTransferStatus) bool {
+		return status.From == alice
+	})
+	if assert.Equal(t, 3, len(statuses)) {
+		assert.Equal(t, uint64(4), statuses[0].RequestNonce)
+		assert.Equal(t, uint64(2), statuses[1].RequestNonce)
+		assert.Equal(t, uint64(0), statuses[2].RequestNonce)
+	}
+
+	statuses = bi.FindValueTransferStatuses(func(status *ValueTransferStatus) bool {
+		return status.Status == VTStatusVoted
+	})
+	assert.Equal(t, 2, len(statuses))
+}
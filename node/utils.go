@@ -88,6 +88,51 @@ func (api *PrivateAdminAPI) RemovePeer(url string) (bool, error) {
 	return true, nil
 }
 
+// BannedPeers returns the bans of node IDs and IP addresses which have not expired.
+func (api *PrivateAdminAPI) BannedPeers() ([]*discover.Ban, error) {
+	server := api.node.Server()
+	if server == nil {
+		return nil, ErrNodeStopped
+	}
+	return server.BannedPeers(), nil
+}
+
+// BanPeer bans a kni URL, a node ID or an IP address for the given seconds, and
+// disconnects the connected peers matching it.
+func (api *PrivateAdminAPI) BanPeer(target string, seconds uint64) (bool, error) {
+	server := api.node.Server()
+	if server == nil {
+		return false, ErrNodeStopped
+	}
+	if seconds == 0 {
+		return false, fmt.Errorf("ban duration must be positive")
+	}
+	ban, err := p2p.ParseBanTarget(target)
+	if err != nil {
+		return false, err
+	}
+	ban.Until = time.Now().Add(time.Duration(seconds) * time.Second)
+	ban.Reason = "banned by admin"
+	if err := server.BanPeer(ban); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnbanPeer lifts the ban of a kni URL, a node ID or an IP address. It returns
+// false if there is no such ban.
+func (api *PrivateAdminAPI) UnbanPeer(target string) (bool, error) {
+	server := api.node.Server()
+	if server == nil {
+		return false, ErrNodeStopped
+	}
+	ban, err := p2p.ParseBanTarget(target)
+	if err != nil {
+		return false, err
+	}
+	return server.UnbanPeer(ban)
+}
+
 // PeerEvents creates an RPC subscription which receives peer events from the
 // node's p2p.Server
 func (api *PrivateAdminAPI) PeerEvents(ctx context.Context) (*rpc.Subscription, error) {
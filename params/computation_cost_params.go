@@ -130,24 +130,30 @@ const (
 	CallCodeComputationCost       = 4000
 	ReturnComputationCost         = 0
 	SelfDestructComputationCost   = 0
+	ChainIDComputationCost        = 120
+	SelfBalanceComputationCost    = 374
 
 	// Computation cost for precompiled contracts
-	EcrecoverComputationCost            = 113150
-	Sha256PerWordComputationCost        = 100
-	Sha256BaseComputationCost           = 1000
-	Ripemd160PerWordComputationCost     = 10
-	Ripemd160BaseComputationCost        = 100
-	IdentityPerWordComputationCost      = 0
-	IdentityBaseComputationCost         = 0
-	BigModExpPerGasComputationCost      = 10
-	BigModExpBaseComputationCost        = 100
-	Bn256AddComputationCost             = 8000
-	Bn256ScalarMulComputationCost       = 100000
-	Bn256ParingBaseComputationCost      = 2000000
-	Bn256ParingPerPointComputationCost  = 1000000
-	VMLogPerByteComputationCost         = 0
-	VMLogBaseComputationCost            = 10
-	FeePayerComputationCost             = 10
-	ValidateSenderPerSigComputationCost = 180000
-	ValidateSenderBaseComputationCost   = 10000
+	EcrecoverComputationCost                = 113150
+	Sha256PerWordComputationCost            = 100
+	Sha256BaseComputationCost               = 1000
+	Ripemd160PerWordComputationCost         = 10
+	Ripemd160BaseComputationCost            = 100
+	IdentityPerWordComputationCost          = 0
+	IdentityBaseComputationCost             = 0
+	BigModExpPerGasComputationCost          = 10
+	BigModExpBaseComputationCost            = 100
+	Bn256AddComputationCost                 = 8000
+	Bn256ScalarMulComputationCost           = 100000
+	Bn256ParingBaseComputationCost          = 2000000
+	Bn256ParingPerPointComputationCost      = 1000000
+	VMLogPerByteComputationCost             = 0
+	VMLogBaseComputationCost                = 10
+	FeePayerComputationCost                 = 10
+	ValidateSenderPerSigComputationCost     = 180000
+	ValidateSenderBaseComputationCost       = 10000
+	Blake2bFRoundComputationCost            = 10
+	Blake2bFBaseComputationCost             = 10000
+	ValidateAccountKeyPerKeyComputationCost = 180000
+	ValidateAccountKeyBaseComputationCost   = 10000
 )
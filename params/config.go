@@ -52,10 +52,12 @@ var (
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
 	AllGxhashProtocolChanges = &ChainConfig{
-		ChainID:  big.NewInt(0),
-		Gxhash:   new(GxhashConfig),
-		Clique:   nil,
-		Istanbul: nil,
+		ChainID:                      big.NewInt(0),
+		IstanbulCompatibleBlock:      big.NewInt(0),
+		HumanReadableCompatibleBlock: big.NewInt(0),
+		Gxhash:                       new(GxhashConfig),
+		Clique:                       nil,
+		Istanbul:                     nil,
 	}
 
 	// AllCliqueProtocolChanges contains every protocol change (GxIPs) introduced
@@ -64,10 +66,12 @@ var (
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
 	AllCliqueProtocolChanges = &ChainConfig{
-		ChainID:  big.NewInt(0),
-		Gxhash:   nil,
-		Clique:   &CliqueConfig{Period: 0, Epoch: 30000},
-		Istanbul: nil,
+		ChainID:                      big.NewInt(0),
+		IstanbulCompatibleBlock:      big.NewInt(0),
+		HumanReadableCompatibleBlock: big.NewInt(0),
+		Gxhash:                       nil,
+		Clique:                       &CliqueConfig{Period: 0, Epoch: 30000},
+		Istanbul:                     nil,
 	}
 
 	TestChainConfig = &ChainConfig{
@@ -118,6 +122,10 @@ const (
 type ChainConfig struct {
 	ChainID *big.Int `json:"chainId"` // chainId identifies the current chain and is used for replay protection
 
+	// Hard fork blocks. A nil block means the hard fork is not scheduled.
+	IstanbulCompatibleBlock      *big.Int `json:"istanbulCompatibleBlock,omitempty"`      // Istanbul switch block (nil = no fork, 0 = already on istanbul)
+	HumanReadableCompatibleBlock *big.Int `json:"humanReadableCompatibleBlock,omitempty"` // Human-readable address switch block (nil = no fork, 0 = already activated)
+
 	// Various consensus engines
 	Gxhash   *GxhashConfig   `json:"gxhash,omitempty"`
 	Clique   *CliqueConfig   `json:"clique,omitempty"`
@@ -195,16 +203,20 @@ func (c *ChainConfig) String() string {
 		engine = "unknown"
 	}
 	if c.Istanbul != nil {
-		return fmt.Sprintf("{ChainID: %v Engine: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d}",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v HumanReadableCompatibleBlock: %v Engine: %v SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d}",
 			c.ChainID,
+			c.IstanbulCompatibleBlock,
+			c.HumanReadableCompatibleBlock,
 			engine,
 			c.Istanbul.SubGroupSize,
 			c.UnitPrice,
 			c.DeriveShaImpl,
 		)
 	} else {
-		return fmt.Sprintf("{ChainID: %v Engine: %v UnitPrice: %d DeriveShaImpl: %d}",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v HumanReadableCompatibleBlock: %v Engine: %v UnitPrice: %d DeriveShaImpl: %d}",
 			c.ChainID,
+			c.IstanbulCompatibleBlock,
+			c.HumanReadableCompatibleBlock,
 			engine,
 			c.UnitPrice,
 			c.DeriveShaImpl,
@@ -212,10 +224,23 @@ func (c *ChainConfig) String() string {
 	}
 }
 
+// IsIstanbul returns whether num is either equal to the istanbul block or greater.
+func (c *ChainConfig) IsIstanbul(num *big.Int) bool {
+	return isForked(c.IstanbulCompatibleBlock, num)
+}
+
+// IsHumanReadable returns whether num is either equal to the human-readable address block or greater.
+func (c *ChainConfig) IsHumanReadable(num *big.Int) bool {
+	return isForked(c.HumanReadableCompatibleBlock, num)
+}
+
 // GasTable returns the gas table corresponding to the current phase.
 //
 // The returned GasTable's fields shouldn't, under any circumstances, be changed.
 func (c *ChainConfig) GasTable(num *big.Int) GasTable {
+	if c.IsIstanbul(num) {
+		return GasTableIstanbul
+	}
 	return GasTableCypress
 }
 
@@ -238,6 +263,12 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *ConfigCompatError {
+	if isForkIncompatible(c.IstanbulCompatibleBlock, newcfg.IstanbulCompatibleBlock, head) {
+		return newCompatError("Istanbul fork block", c.IstanbulCompatibleBlock, newcfg.IstanbulCompatibleBlock)
+	}
+	if isForkIncompatible(c.HumanReadableCompatibleBlock, newcfg.HumanReadableCompatibleBlock, head) {
+		return newCompatError("human-readable address fork block", c.HumanReadableCompatibleBlock, newcfg.HumanReadableCompatibleBlock)
+	}
 	return nil
 }
 
@@ -302,7 +333,9 @@ func (err *ConfigCompatError) Error() string {
 // Rules is a one time interface meaning that it shouldn't be used in between transition
 // phases.
 type Rules struct {
-	ChainID *big.Int
+	ChainID         *big.Int
+	IsIstanbul      bool
+	IsHumanReadable bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -311,7 +344,11 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 	if chainID == nil {
 		chainID = new(big.Int)
 	}
-	return Rules{ChainID: new(big.Int).Set(chainID)}
+	return Rules{
+		ChainID:         new(big.Int).Set(chainID),
+		IsIstanbul:      c.IsIstanbul(num),
+		IsHumanReadable: c.IsHumanReadable(num),
+	}
 }
 
 // Copy copies self to a new governance config and return it
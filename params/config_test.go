@@ -0,0 +1,106 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package params
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestCheckCompatible(t *testing.T) {
+	type test struct {
+		stored, new *ChainConfig
+		head        uint64
+		wantErr     *ConfigCompatError
+	}
+	tests := []test{
+		{stored: AllGxhashProtocolChanges, new: AllGxhashProtocolChanges, head: 0, wantErr: nil},
+		{stored: AllGxhashProtocolChanges, new: AllGxhashProtocolChanges, head: 100, wantErr: nil},
+		{
+			stored:  &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)},
+			new:     &ChainConfig{IstanbulCompatibleBlock: big.NewInt(20)},
+			head:    9,
+			wantErr: nil,
+		},
+		{
+			stored: AllGxhashProtocolChanges,
+			new:    &ChainConfig{IstanbulCompatibleBlock: nil},
+			head:   3,
+			wantErr: &ConfigCompatError{
+				What:         "Istanbul fork block",
+				StoredConfig: big.NewInt(0),
+				NewConfig:    nil,
+				RewindTo:     0,
+			},
+		},
+		{
+			stored: &ChainConfig{IstanbulCompatibleBlock: big.NewInt(10)},
+			new:    &ChainConfig{IstanbulCompatibleBlock: big.NewInt(20)},
+			head:   25,
+			wantErr: &ConfigCompatError{
+				What:         "Istanbul fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(20),
+				RewindTo:     9,
+			},
+		},
+		{
+			stored: &ChainConfig{},
+			new:    &ChainConfig{IstanbulCompatibleBlock: big.NewInt(5)},
+			head:   5,
+			wantErr: &ConfigCompatError{
+				What:         "Istanbul fork block",
+				StoredConfig: nil,
+				NewConfig:    big.NewInt(5),
+				RewindTo:     4,
+			},
+		},
+		{
+			stored: &ChainConfig{IstanbulCompatibleBlock: big.NewInt(0), HumanReadableCompatibleBlock: big.NewInt(10)},
+			new:    &ChainConfig{IstanbulCompatibleBlock: big.NewInt(0)},
+			head:   12,
+			wantErr: &ConfigCompatError{
+				What:         "human-readable address fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		err := test.stored.CheckCompatible(test.new, test.head)
+		if !reflect.DeepEqual(err, test.wantErr) {
+			t.Errorf("error mismatch:\nstored: %v\nnew: %v\nhead: %v\nerr: %v\nwant: %v", test.stored, test.new, test.head, err, test.wantErr)
+		}
+	}
+}
+
+func TestIsIstanbul(t *testing.T) {
+	config := &ChainConfig{ChainID: big.NewInt(1), IstanbulCompatibleBlock: big.NewInt(10)}
+
+	if config.Rules(big.NewInt(9)).IsIstanbul {
+		t.Error("Istanbul should not be activated before the fork block")
+	}
+	if !config.Rules(big.NewInt(10)).IsIstanbul {
+		t.Error("Istanbul should be activated at the fork block")
+	}
+	if (&ChainConfig{}).Rules(big.NewInt(10)).IsIstanbul {
+		t.Error("Istanbul should not be activated if the fork block is not scheduled")
+	}
+}
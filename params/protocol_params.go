@@ -58,6 +58,16 @@ const (
 	LogTopicGas           uint64 = 375   // Multiplied by the * of the LOG*, per LOG transaction. e.g. LOG0 incurs 0 * c_txLogTopicGas, LOG4 incurs 4 * c_txLogTopicGas.   // G_logtopic
 	TxDataNonZeroGas      uint64 = 68    // Per byte of data attached to a transaction that is not equal to zero. NOTE: Not payable on data of calls between transactions. // G_txdatanonzero
 
+	// Gas prices of SSTORE after the Istanbul fork (EIP-2200)
+	SstoreSentryGasEIP2200   uint64 = 2300  // Minimum gas required to be present for an SSTORE call, not consumed
+	SstoreNoopGasEIP2200     uint64 = 800   // Once per SSTORE operation if the value doesn't change.
+	SstoreDirtyGasEIP2200    uint64 = 800   // Once per SSTORE operation if a dirty value is changed.
+	SstoreInitGasEIP2200     uint64 = 20000 // Once per SSTORE operation from clean zero to non-zero
+	SstoreInitRefundEIP2200  uint64 = 19200 // Once per SSTORE operation for resetting to the original zero value
+	SstoreCleanGasEIP2200    uint64 = 5000  // Once per SSTORE operation from clean non-zero to something else
+	SstoreCleanRefundEIP2200 uint64 = 4200  // Once per SSTORE operation for resetting to the original non-zero value
+	SstoreClearRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
+
 	// Fee for Service Chain
 	// TODO-Klaytn-ServiceChain The following parameters should be fixed.
 	// TODO-Klaytn-Governance The following parameters should be able to be modified by governance.
@@ -66,22 +76,25 @@ const (
 
 	// Precompiled contract gas prices
 
-	EcrecoverGas            uint64 = 3000   // Elliptic curve sender recovery gas price
-	Sha256BaseGas           uint64 = 60     // Base price for a SHA256 operation
-	Sha256PerWordGas        uint64 = 12     // Per-word price for a SHA256 operation
-	Ripemd160BaseGas        uint64 = 600    // Base price for a RIPEMD160 operation
-	Ripemd160PerWordGas     uint64 = 120    // Per-word price for a RIPEMD160 operation
-	IdentityBaseGas         uint64 = 15     // Base price for a data copy operation
-	IdentityPerWordGas      uint64 = 3      // Per-work price for a data copy operation
-	ModExpQuadCoeffDiv      uint64 = 20     // Divisor for the quadratic particle of the big int modular exponentiation
-	Bn256AddGas             uint64 = 500    // Gas needed for an elliptic curve addition
-	Bn256ScalarMulGas       uint64 = 40000  // Gas needed for an elliptic curve scalar multiplication
-	Bn256PairingBaseGas     uint64 = 100000 // Base price for an elliptic curve pairing check
-	Bn256PairingPerPointGas uint64 = 80000  // Per-point price for an elliptic curve pairing check
-	VMLogBaseGas            uint64 = 100    // Base price for a VMLOG operation
-	VMLogPerByteGas         uint64 = 20     // Per-byte price for a VMLOG operation
-	FeePayerGas             uint64 = 300    // Gas needed for calculating the fee payer of the transaction in a smart contract.
-	ValidateSenderGas       uint64 = 5000   // Gas needed for validating the signature of a message.
+	EcrecoverGas                uint64 = 3000   // Elliptic curve sender recovery gas price
+	Sha256BaseGas               uint64 = 60     // Base price for a SHA256 operation
+	Sha256PerWordGas            uint64 = 12     // Per-word price for a SHA256 operation
+	Ripemd160BaseGas            uint64 = 600    // Base price for a RIPEMD160 operation
+	Ripemd160PerWordGas         uint64 = 120    // Per-word price for a RIPEMD160 operation
+	IdentityBaseGas             uint64 = 15     // Base price for a data copy operation
+	IdentityPerWordGas          uint64 = 3      // Per-work price for a data copy operation
+	ModExpQuadCoeffDiv          uint64 = 20     // Divisor for the quadratic particle of the big int modular exponentiation
+	Bn256AddGas                 uint64 = 500    // Gas needed for an elliptic curve addition
+	Bn256ScalarMulGas           uint64 = 40000  // Gas needed for an elliptic curve scalar multiplication
+	Bn256PairingBaseGas         uint64 = 100000 // Base price for an elliptic curve pairing check
+	Bn256PairingPerPointGas     uint64 = 80000  // Per-point price for an elliptic curve pairing check
+	VMLogBaseGas                uint64 = 100    // Base price for a VMLOG operation
+	VMLogPerByteGas             uint64 = 20     // Per-byte price for a VMLOG operation
+	FeePayerGas                 uint64 = 300    // Gas needed for calculating the fee payer of the transaction in a smart contract.
+	ValidateSenderGas           uint64 = 5000   // Gas needed for validating the signature of a message.
+	Blake2bFRoundGas            uint64 = 1      // Per-round price for a BLAKE2b F compression
+	ValidateAccountKeyBaseGas   uint64 = 1000   // Base price for validating signatures with an account key
+	ValidateAccountKeyPerKeyGas uint64 = 5000   // Per-key price for validating signatures with an account key
 
 	GasLimitBoundDivisor uint64 = 1024    // The bound divisor of the gas limit, used in update calculations.
 	MinGasLimit          uint64 = 5000    // Minimum the gas limit may ever be.
@@ -155,6 +155,17 @@ type DBManager interface {
 	WriteHandleTxHashFromRequestTxHash(rTx, hTx common.Hash)
 	ReadHandleTxHashFromRequestTxHash(rTx common.Hash) common.Hash
 
+	WriteValueTransferStatus(bridgeAddr common.Address, requestNonce uint64, encodedStatus []byte)
+	ReadValueTransferStatus(bridgeAddr common.Address, requestNonce uint64) []byte
+
+	// Fee payer service related functions.
+	WriteFeePayerUsage(sender common.Address, period uint64, encodedUsage []byte)
+	ReadFeePayerUsage(sender common.Address, period uint64) []byte
+
+	// Account key history related functions.
+	WriteAccountKeyHistory(addr common.Address, encodedHistory []byte)
+	ReadAccountKeyHistory(addr common.Address) []byte
+
 	// cacheManager related functions.
 	ClearHeaderChainCache()
 	ClearBlockChainCache()
@@ -1418,6 +1429,70 @@ func (dbm *databaseManager) ReadHandleTxHashFromRequestTxHash(rTx common.Hash) c
 	return common.BytesToHash(data)
 }
 
+// WriteValueTransferStatus writes the encoded status of the value transfer
+// requested to the given bridge with the given request nonce.
+func (dbm *databaseManager) WriteValueTransferStatus(bridgeAddr common.Address, requestNonce uint64, encodedStatus []byte) {
+	db := dbm.getDatabase(bridgeServiceDB)
+	key := valueTransferStatusKey(bridgeAddr, requestNonce)
+	if err := db.Put(key, encodedStatus); err != nil {
+		logger.Crit("Failed to store value transfer status", "bridge", bridgeAddr.String(), "request nonce", requestNonce, "err", err)
+	}
+}
+
+// ReadValueTransferStatus returns the encoded status of the value transfer
+// requested to the given bridge with the given request nonce.
+func (dbm *databaseManager) ReadValueTransferStatus(bridgeAddr common.Address, requestNonce uint64) []byte {
+	key := valueTransferStatusKey(bridgeAddr, requestNonce)
+	db := dbm.getDatabase(bridgeServiceDB)
+	data, _ := db.Get(key)
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
+// WriteFeePayerUsage writes the encoded usage of the fee payer service by the given
+// sender during the given quota period.
+func (dbm *databaseManager) WriteFeePayerUsage(sender common.Address, period uint64, encodedUsage []byte) {
+	db := dbm.getDatabase(MiscDB)
+	key := feePayerUsageKey(sender, period)
+	if err := db.Put(key, encodedUsage); err != nil {
+		logger.Crit("Failed to store fee payer usage", "sender", sender.String(), "period", period, "err", err)
+	}
+}
+
+// ReadFeePayerUsage returns the encoded usage of the fee payer service by the given
+// sender during the given quota period.
+func (dbm *databaseManager) ReadFeePayerUsage(sender common.Address, period uint64) []byte {
+	key := feePayerUsageKey(sender, period)
+	db := dbm.getDatabase(MiscDB)
+	data, _ := db.Get(key)
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
+// WriteAccountKeyHistory writes the encoded history of the account key changes of the given address.
+func (dbm *databaseManager) WriteAccountKeyHistory(addr common.Address, encodedHistory []byte) {
+	db := dbm.getDatabase(MiscDB)
+	key := accountKeyHistoryKey(addr)
+	if err := db.Put(key, encodedHistory); err != nil {
+		logger.Crit("Failed to store account key history", "address", addr.String(), "err", err)
+	}
+}
+
+// ReadAccountKeyHistory returns the encoded history of the account key changes of the given address.
+func (dbm *databaseManager) ReadAccountKeyHistory(addr common.Address) []byte {
+	key := accountKeyHistoryKey(addr)
+	db := dbm.getDatabase(MiscDB)
+	data, _ := db.Get(key)
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
 // WriteReceiptFromParentChain writes a receipt received from parent chain to child chain
 // with corresponding block hash. It assumes that a child chain has only one parent chain.
 func (dbm *databaseManager) WriteReceiptFromParentChain(blockHash common.Hash, receipt *types.Receipt) {
@@ -598,6 +598,32 @@ func TestDBManager_ChildChain(t *testing.T) {
 
 		dbm.WriteHandleTxHashFromRequestTxHash(hash1, hash2)
 		assert.Equal(t, hash2, dbm.ReadHandleTxHashFromRequestTxHash(hash1))
+
+		// 4. Read/Write ValueTransferStatus
+		assert.Nil(t, dbm.ReadValueTransferStatus(addr, num1))
+
+		dbm.WriteValueTransferStatus(addr, num1, hash1.Bytes())
+		assert.Equal(t, hash1.Bytes(), dbm.ReadValueTransferStatus(addr, num1))
+		assert.Nil(t, dbm.ReadValueTransferStatus(addr, num2))
+
+		dbm.WriteValueTransferStatus(addr, num1, hash2.Bytes())
+		assert.Equal(t, hash2.Bytes(), dbm.ReadValueTransferStatus(addr, num1))
+
+		// 5. Read/Write FeePayerUsage
+		assert.Nil(t, dbm.ReadFeePayerUsage(addr, num1))
+
+		dbm.WriteFeePayerUsage(addr, num1, hash1.Bytes())
+		assert.Equal(t, hash1.Bytes(), dbm.ReadFeePayerUsage(addr, num1))
+		assert.Nil(t, dbm.ReadFeePayerUsage(addr, num2))
+
+		// 6. Read/Write AccountKeyHistory
+		assert.Nil(t, dbm.ReadAccountKeyHistory(addr))
+
+		dbm.WriteAccountKeyHistory(addr, hash1.Bytes())
+		assert.Equal(t, hash1.Bytes(), dbm.ReadAccountKeyHistory(addr))
+
+		dbm.WriteAccountKeyHistory(addr, hash2.Bytes())
+		assert.Equal(t, hash2.Bytes(), dbm.ReadAccountKeyHistory(addr))
 	}
 }
 
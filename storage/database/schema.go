@@ -75,6 +75,11 @@ var (
 	receiptFromParentChainKeyPrefix = []byte("receiptFromParentChain")
 
 	valueTransferTxHashPrefix = []byte("vt-tx-hash-key-") // Prefix + hash -> hash
+	valueTransferStatusPrefix = []byte("vt-status-key-")  // Prefix + bridge address + nonce (uint64 big endian) -> status
+
+	feePayerUsagePrefix = []byte("feepayer-usage-") // Prefix + sender address + period (uint64 big endian) -> usage
+
+	accountKeyHistoryPrefix = []byte("account-key-history-") // Prefix + address -> account key history
 
 	// bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
 	bloomBitsPrefix = []byte("B")
@@ -170,6 +175,21 @@ func valueTransferTxHashKey(rTxHash common.Hash) []byte {
 	return append(valueTransferTxHashPrefix, rTxHash.Bytes()...)
 }
 
+// valueTransferStatusKey = valueTransferStatusPrefix + bridge address + nonce (uint64 big endian)
+func valueTransferStatusKey(bridgeAddr common.Address, requestNonce uint64) []byte {
+	return append(append(valueTransferStatusPrefix, bridgeAddr.Bytes()...), encodeBlockNumber(requestNonce)...)
+}
+
+// feePayerUsageKey = feePayerUsagePrefix + sender address + period (uint64 big endian)
+func feePayerUsageKey(sender common.Address, period uint64) []byte {
+	return append(append(feePayerUsagePrefix, sender.Bytes()...), encodeBlockNumber(period)...)
+}
+
+// accountKeyHistoryKey = accountKeyHistoryPrefix + address
+func accountKeyHistoryKey(addr common.Address) []byte {
+	return append(accountKeyHistoryPrefix, addr.Bytes()...)
+}
+
 // bloomBitsKey = bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash
 func BloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	key := append(append(bloomBitsPrefix, make([]byte, 10)...), hash.Bytes()...)
@@ -22,14 +22,24 @@ package statedb
 
 import (
 	"bytes"
+	"errors"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/ser/rlp"
+	"github.com/klaytn/klaytn/storage/database"
 )
 
+var errProofIndexOutOfRange = errors.New("the index of the proof is out of range")
+
 type DeriveShaOrig struct{}
 
 func (d DeriveShaOrig) DeriveSha(list types.DerivableList) common.Hash {
+	return deriveShaOrigTrie(list).Hash()
+}
+
+// deriveShaOrigTrie returns the trie whose hash is the DeriveShaOrig hash of the list.
+func deriveShaOrigTrie(list types.DerivableList) *Trie {
 	keybuf := new(bytes.Buffer)
 	trie := new(Trie)
 	for i := 0; i < list.Len(); i++ {
@@ -37,5 +47,56 @@ func (d DeriveShaOrig) DeriveSha(list types.DerivableList) common.Hash {
 		rlp.Encode(keybuf, uint(i))
 		trie.Update(keybuf.Bytes(), list.GetRlp(i))
 	}
-	return trie.Hash()
+	return trie
+}
+
+// DeriveShaOrigProof returns the merkle proof of the index-th item of the list
+// in the trie made by DeriveShaOrig. The proof is a set of RLP-encoded trie nodes.
+func DeriveShaOrigProof(list types.DerivableList, index int) ([][]byte, error) {
+	if index < 0 || index >= list.Len() {
+		return nil, errProofIndexOutOfRange
+	}
+	key, err := rlp.EncodeToBytes(uint(index))
+	if err != nil {
+		return nil, err
+	}
+
+	proofDB := database.NewMemoryDBManager()
+	if err := deriveShaOrigTrie(list).Prove(key, 0, proofDB); err != nil {
+		return nil, err
+	}
+
+	memDB := proofDB.GetMemDB()
+	proof := make([][]byte, 0, memDB.Len())
+	for _, k := range memDB.Keys() {
+		node, err := memDB.Get(k)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, node)
+	}
+	return proof, nil
+}
+
+// VerifyDeriveShaOrigProof checks the merkle proof made by DeriveShaOrigProof against the given root hash.
+// It returns the RLP-encoded index-th item of the list if the proof is valid.
+func VerifyDeriveShaOrigProof(rootHash common.Hash, index int, proof [][]byte) ([]byte, error) {
+	key, err := rlp.EncodeToBytes(uint(index))
+	if err != nil {
+		return nil, err
+	}
+
+	proofDB := database.NewMemoryDBManager()
+	for _, node := range proof {
+		proofDB.WriteMerkleProof(crypto.Keccak256(node), node)
+	}
+
+	value, err, _ := VerifyProof(rootHash, key, proofDB)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, errProofIndexOutOfRange
+	}
+	return value, nil
 }
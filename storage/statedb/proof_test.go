@@ -168,3 +168,37 @@ func randBytes(n int) []byte {
 	crand.Read(r)
 	return r
 }
+
+type testDerivableList [][]byte
+
+func (l testDerivableList) Len() int            { return len(l) }
+func (l testDerivableList) GetRlp(i int) []byte { return l[i] }
+
+func TestDeriveShaOrigProof(t *testing.T) {
+	list := testDerivableList{}
+	for i := 0; i < 300; i++ {
+		list = append(list, randBytes(mrand.Intn(100)+1))
+	}
+	root := DeriveShaOrig{}.DeriveSha(list)
+
+	for i := range list {
+		proof, err := DeriveShaOrigProof(list, i)
+		if err != nil {
+			t.Fatalf("failed to make proof of item %d: %v", i, err)
+		}
+		val, err := VerifyDeriveShaOrigProof(root, i, proof)
+		if err != nil {
+			t.Fatalf("VerifyDeriveShaOrigProof error for item %d: %v", i, err)
+		}
+		if !bytes.Equal(val, list[i]) {
+			t.Fatalf("VerifyDeriveShaOrigProof returned wrong value for item %d: got %x, want %x", i, val, list[i])
+		}
+		if _, err := VerifyDeriveShaOrigProof(common.Hash{}, i, proof); err == nil {
+			t.Fatalf("expected error for the proof of item %d with wrong root", i)
+		}
+	}
+
+	if _, err := DeriveShaOrigProof(list, len(list)); err == nil {
+		t.Fatal("expected error for the out of range index")
+	}
+}
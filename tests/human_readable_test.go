@@ -0,0 +1,229 @@
+// Copyright 2019 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"crypto/ecdsa"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/profile"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// TestHumanReadableAccountCreation registers a human-readable address and sends a transaction from it.
+// It also checks that the collisions and the invalid registrations are rejected in txPool and execution process.
+func TestHumanReadableAccountCreation(t *testing.T) {
+	gasPrice := new(big.Int).SetUint64(25 * params.Ston)
+
+	if testing.Verbose() {
+		enableLog()
+	}
+	prof := profile.NewProfiler()
+
+	// Initialize blockchain
+	bcdata, err := NewBCData(6, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bcdata.Shutdown()
+
+	// Initialize address-balance map for verification
+	accountMap := NewAccountMap()
+	if err := accountMap.Initialize(bcdata); err != nil {
+		t.Fatal(err)
+	}
+
+	signer := types.NewEIP155Signer(bcdata.bc.Config().ChainID)
+
+	// reservoir account
+	reservoir := &TestAccountType{
+		Addr:  *bcdata.addrs[0],
+		Keys:  []*ecdsa.PrivateKey{bcdata.privKeys[0]},
+		Nonce: uint64(0),
+	}
+
+	colinAddr, err := common.HumanReadableToAddress("colin.klaytn")
+	assert.Equal(t, nil, err)
+
+	colin, err := createDecoupledAccount("ed580f5bd71a2ee4dae5cb43e331b7d0318596e561e6add7844271ed94156b20", colinAddr)
+	assert.Equal(t, nil, err)
+
+	anon, err := createAnonymousAccount("a5c9a50938a089618167c9d67dbebc0deaffc3c76ddc6b40c2777ae59438e989")
+	assert.Equal(t, nil, err)
+
+	genAccountCreationTx := func(to common.Address, humanReadable bool, key accountkey.AccountKey) *types.Transaction {
+		values := map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:         reservoir.Nonce,
+			types.TxValueKeyFrom:          reservoir.Addr,
+			types.TxValueKeyTo:            to,
+			types.TxValueKeyAmount:        new(big.Int).SetUint64(params.KLAY),
+			types.TxValueKeyGasLimit:      gasLimit,
+			types.TxValueKeyGasPrice:      gasPrice,
+			types.TxValueKeyHumanReadable: humanReadable,
+			types.TxValueKeyAccountKey:    key,
+		}
+		tx, err := types.NewAccountCreationTransactionWithMap(values)
+		assert.Equal(t, nil, err)
+
+		err = tx.SignWithKeys(signer, reservoir.Keys)
+		assert.Equal(t, nil, err)
+		return tx
+	}
+
+	// the account creation is rejected before the human-readable address fork
+	{
+		txpool := blockchain.NewTxPool(blockchain.DefaultTxPoolConfig, bcdata.bc.Config(), bcdata.bc)
+		tx := genAccountCreationTx(colin.Addr, true, colin.AccKey)
+		assert.Equal(t, types.ErrTxTypeNotActivated, txpool.AddRemote(tx))
+
+		receipt, _, err := applyTransaction(t, bcdata, tx)
+		assert.Equal(t, types.ErrTxTypeNotActivated, err)
+		assert.Equal(t, (*types.Receipt)(nil), receipt)
+	}
+
+	// activate human-readable addresses from the next block
+	bcdata.bc.Config().HumanReadableCompatibleBlock = new(big.Int).Add(bcdata.bc.CurrentBlock().Number(), common.Big1)
+	defer func() { bcdata.bc.Config().HumanReadableCompatibleBlock = nil }()
+
+	// register colin.klaytn
+	{
+		tx := genAccountCreationTx(colin.Addr, true, colin.AccKey)
+		if err := bcdata.GenABlockWithTransactions(accountMap, types.Transactions{tx}, prof); err != nil {
+			t.Fatal(err)
+		}
+		reservoir.Nonce += 1
+
+		state, err := bcdata.bc.State()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, state.IsHumanReadable(colin.Addr))
+		assert.Equal(t, true, state.GetKey(colin.Addr).Equal(colin.AccKey))
+		assert.Equal(t, false, state.IsHumanReadable(reservoir.Addr))
+	}
+
+	// colin.klaytn sends KLAY with its account key
+	{
+		values := map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:    colin.Nonce,
+			types.TxValueKeyFrom:     colin.Addr,
+			types.TxValueKeyTo:       anon.Addr,
+			types.TxValueKeyAmount:   big.NewInt(1000),
+			types.TxValueKeyGasLimit: uint64(100000),
+			types.TxValueKeyGasPrice: gasPrice,
+		}
+		tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, values)
+		assert.Equal(t, nil, err)
+
+		err = tx.SignWithKeys(signer, colin.Keys)
+		assert.Equal(t, nil, err)
+
+		if err := bcdata.GenABlockWithTransactions(accountMap, types.Transactions{tx}, prof); err != nil {
+			t.Fatal(err)
+		}
+		colin.Nonce += 1
+	}
+
+	// bobby.klaytn is registered even though someone has sent KLAY to it in advance
+	{
+		bob, err := createDecoupledAccount("c64f2cd1196e2a1791365b00c4bc07ab8f9b7e3d5b2dc5b9a4bbcc6a8a73d2b2", mustHumanReadableToAddress(t, "bobby.klaytn"))
+		assert.Equal(t, nil, err)
+
+		values := map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:    reservoir.Nonce,
+			types.TxValueKeyFrom:     reservoir.Addr,
+			types.TxValueKeyTo:       bob.Addr,
+			types.TxValueKeyAmount:   big.NewInt(1),
+			types.TxValueKeyGasLimit: gasLimit,
+			types.TxValueKeyGasPrice: gasPrice,
+		}
+		tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, values)
+		assert.Equal(t, nil, err)
+
+		err = tx.SignWithKeys(signer, reservoir.Keys)
+		assert.Equal(t, nil, err)
+
+		if err := bcdata.GenABlockWithTransactions(accountMap, types.Transactions{tx}, prof); err != nil {
+			t.Fatal(err)
+		}
+		reservoir.Nonce += 1
+
+		tx = genAccountCreationTx(bob.Addr, true, bob.AccKey)
+		if err := bcdata.GenABlockWithTransactions(accountMap, types.Transactions{tx}, prof); err != nil {
+			t.Fatal(err)
+		}
+		reservoir.Nonce += 1
+
+		state, err := bcdata.bc.State()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, state.IsHumanReadable(bob.Addr))
+		assert.Equal(t, true, state.GetKey(bob.Addr).Equal(bob.AccKey))
+		assert.Equal(t, new(big.Int).SetUint64(params.KLAY+1), state.GetBalance(bob.Addr))
+	}
+
+	// make TxPool to test validation in 'TxPool add' process
+	txpool := blockchain.NewTxPool(blockchain.DefaultTxPoolConfig, bcdata.bc.Config(), bcdata.bc)
+
+	invalidTxs := []struct {
+		name string
+		tx   *types.Transaction
+		err  error
+	}{
+		{"collision", genAccountCreationTx(colin.Addr, true, accountkey.NewAccountKeyPublicWithValue(&anon.Keys[0].PublicKey)), kerrors.ErrAccountAlreadyExists},
+		{"not human-readable flag", genAccountCreationTx(mustHumanReadableToAddress(t, "alice.klaytn"), false, colin.AccKey), kerrors.ErrNotHumanReadableAddress},
+		{"not human-readable address", genAccountCreationTx(anon.Addr, true, colin.AccKey), kerrors.ErrNotHumanReadableAddress},
+		{"legacy key", genAccountCreationTx(mustHumanReadableToAddress(t, "alice.klaytn"), true, accountkey.NewAccountKeyLegacy()), kerrors.ErrLegacyKeyForHumanReadable},
+	}
+	for _, invalid := range invalidTxs {
+		// fail to add tx in txPool
+		err = txpool.AddRemote(invalid.tx)
+		assert.Equal(t, invalid.err, err, invalid.name)
+
+		// fail to execute tx
+		receipt, _, err := applyTransaction(t, bcdata, invalid.tx)
+		assert.Equal(t, invalid.err, err, invalid.name)
+		assert.Equal(t, (*types.Receipt)(nil), receipt, invalid.name)
+	}
+
+	// the txPool of a service chain prevents the account creation
+	{
+		poolConfig := blockchain.DefaultTxPoolConfig
+		poolConfig.NoAccountCreation = true
+		scTxPool := blockchain.NewTxPool(poolConfig, bcdata.bc.Config(), bcdata.bc)
+
+		tx := genAccountCreationTx(mustHumanReadableToAddress(t, "alice.klaytn"), true, colin.AccKey)
+		assert.Equal(t, blockchain.ErrAccountCreationPrevented, scTxPool.AddRemote(tx))
+	}
+
+	state, err := bcdata.bc.State()
+	assert.Equal(t, nil, err)
+	if err := accountMap.Verify(state); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustHumanReadableToAddress(t *testing.T, hra string) common.Address {
+	addr, err := common.HumanReadableToAddress(hra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
@@ -22,7 +22,6 @@ package tests
 
 import (
 	"crypto/ecdsa"
-	"errors"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/kerrors"
@@ -46,16 +45,13 @@ func TestTransaction(t *testing.T) {
 	})
 }
 
-// TestAccountCreationDisable tries to use accountCreation tx types which is disabled now.
+// TestAccountCreationDisable tries to use accountCreation tx types before the human-readable address fork.
 // The tx should be invalided in txPool and execution process.
 func TestAccountCreationDisable(t *testing.T) {
 	if testing.Verbose() {
 		enableLog()
 	}
 
-	// the same with types.errUndefinedTxType
-	errUndefinedTxType := errors.New("undefined tx type")
-
 	// Initialize blockchain
 	bcdata, err := NewBCData(6, 4)
 	if err != nil {
@@ -105,11 +101,11 @@ func TestAccountCreationDisable(t *testing.T) {
 
 		// fail to add tx in txPool
 		err = txpool.AddRemote(tx)
-		assert.Equal(t, errUndefinedTxType, err)
+		assert.Equal(t, types.ErrTxTypeNotActivated, err)
 
 		// fail to execute tx
 		receipt, _, err := applyTransaction(t, bcdata, tx)
-		assert.Equal(t, errUndefinedTxType, err)
+		assert.Equal(t, types.ErrTxTypeNotActivated, err)
 		assert.Equal(t, (*types.Receipt)(nil), receipt)
 	}
 }
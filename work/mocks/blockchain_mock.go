@@ -453,6 +453,20 @@ func (mr *MockBlockChainMockRecorder) GetTxLookupInfoAndReceiptInCache(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxLookupInfoAndReceiptInCache", reflect.TypeOf((*MockBlockChain)(nil).GetTxLookupInfoAndReceiptInCache), arg0)
 }
 
+// GetVMConfig mocks base method
+func (m *MockBlockChain) GetVMConfig() *vm.Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMConfig")
+	ret0, _ := ret[0].(*vm.Config)
+	return ret0
+}
+
+// GetVMConfig indicates an expected call of GetVMConfig
+func (mr *MockBlockChainMockRecorder) GetVMConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMConfig", reflect.TypeOf((*MockBlockChain)(nil).GetVMConfig))
+}
+
 // HasBadBlock mocks base method
 func (m *MockBlockChain) HasBadBlock(arg0 common.Hash) bool {
 	m.ctrl.T.Helper()
@@ -568,6 +582,21 @@ func (mr *MockBlockChainMockRecorder) IsSenderTxHashIndexingEnabled() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSenderTxHashIndexingEnabled", reflect.TypeOf((*MockBlockChain)(nil).IsSenderTxHashIndexingEnabled))
 }
 
+// GetAccountKeyHistory mocks base method
+func (m *MockBlockChain) GetAccountKeyHistory(arg0 common.Address) ([]*blockchain.AccountKeyChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountKeyHistory", arg0)
+	ret0, _ := ret[0].([]*blockchain.AccountKeyChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountKeyHistory indicates an expected call of GetAccountKeyHistory
+func (mr *MockBlockChainMockRecorder) GetAccountKeyHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountKeyHistory", reflect.TypeOf((*MockBlockChain)(nil).GetAccountKeyHistory), arg0)
+}
+
 // PostChainEvents mocks base method
 func (m *MockBlockChain) PostChainEvents(arg0 []interface{}, arg1 []*types.Log) {
 	m.ctrl.T.Helper()
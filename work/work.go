@@ -262,6 +262,7 @@ type BlockChain interface {
 	SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
 	IsParallelDBWrite() bool
 	IsSenderTxHashIndexingEnabled() bool
+	GetAccountKeyHistory(addr common.Address) ([]*blockchain.AccountKeyChange, error)
 
 	// Used in governance pkg
 	SetProposerPolicy(val uint64)
@@ -272,6 +273,7 @@ type BlockChain interface {
 	StateAt(root common.Hash) (*state.StateDB, error)
 	Export(w io.Writer) error
 	Engine() consensus.Engine
+	GetVMConfig() *vm.Config
 	GetNonceInCache(addr common.Address) (uint64, bool)
 	GetTxLookupInfoAndReceipt(txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, *types.Receipt)
 	GetTxAndLookupInfoInCache(hash common.Hash) (*types.Transaction, common.Hash, uint64, uint64)